@@ -0,0 +1,213 @@
+// Package animate renders the iterations of the cellular automata as an animated GIF or a sequence of PNG frames
+package animate
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+)
+
+// Background is the cell character used for the padding of smaller frames and the gaps between the layers
+const Background = ' '
+
+// DefaultColors are used for the cell characters without a configured color
+var DefaultColors = map[byte]color.Color{
+	Background: color.RGBA{R: 0xdd, G: 0xdd, B: 0xdd, A: 0xff},
+	'#':        color.RGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xff},
+	'.':        color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	'L':        color.RGBA{R: 0x2e, G: 0x8b, B: 0x57, A: 0xff},
+}
+
+// fallbackColors are assigned to the characters which are neither configured nor in the defaults
+var fallbackColors = []color.Color{
+	color.RGBA{R: 0xe6, G: 0x19, B: 0x4b, A: 0xff},
+	color.RGBA{R: 0x43, G: 0x63, B: 0xd8, A: 0xff},
+	color.RGBA{R: 0xf5, G: 0x82, B: 0x31, A: 0xff},
+	color.RGBA{R: 0x91, G: 0x1e, B: 0xb4, A: 0xff},
+}
+
+type Options struct {
+	CellSize int                  // size of a cell in pixels
+	Delay    time.Duration        // how long each frame of the GIF is shown
+	Colors   map[byte]color.Color // color of each cell character (overriding DefaultColors)
+}
+
+// Animation collects the frames, each frame is a grid of characters (the same ones the puzzle uses for the cell states)
+type Animation struct {
+	options Options
+	frames  []*grid.Grid[byte]
+}
+
+func New(options Options) *Animation {
+	if options.CellSize < 1 {
+		options.CellSize = 1
+	}
+	return &Animation{options: options}
+}
+
+// Add appends a copy of the frame to the animation
+func (a *Animation) Add(frame *grid.Grid[byte]) {
+	a.frames = append(a.frames, frame.Clone())
+}
+
+func (a *Animation) Len() int {
+	return len(a.frames)
+}
+
+// Save writes the animation as a GIF (path ending with .gif) or as PNG frames (path ending with .png, the frame number
+// is added before the extension, e.g. out_000.png)
+func (a *Animation) Save(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gif":
+		return writeFile(path, a.WriteGIF)
+	case ".png":
+		images, err := a.images()
+		if err != nil {
+			return err
+		}
+		base := strings.TrimSuffix(path, filepath.Ext(path))
+		for i, img := range images {
+			err = writeFile(fmt.Sprintf("%s_%03d.png", base, i), func(w io.Writer) error {
+				return png.Encode(w, img)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported animation file [%s], expected .gif or .png", path)
+	}
+}
+
+func (a *Animation) WriteGIF(w io.Writer) error {
+	images, err := a.images()
+	if err != nil {
+		return err
+	}
+	delay := int(a.options.Delay / (10 * time.Millisecond)) // in hundredths of a second
+	animation := &gif.GIF{
+		Image: images,
+		Delay: make([]int, len(images)),
+	}
+	for i := range animation.Delay {
+		animation.Delay[i] = delay
+	}
+	return gif.EncodeAll(w, animation)
+}
+
+// images renders all the frames with the same size (smaller frames are centered)
+func (a *Animation) images() ([]*image.Paletted, error) {
+	if len(a.frames) == 0 {
+		return nil, fmt.Errorf("no frames to render")
+	}
+	width, height := 0, 0
+	for _, frame := range a.frames {
+		width = max(width, frame.Width())
+		height = max(height, frame.Height())
+	}
+	palette, indexes, err := a.getPalette()
+	if err != nil {
+		return nil, err
+	}
+
+	cellSize := a.options.CellSize
+	images := make([]*image.Paletted, len(a.frames))
+	for i, frame := range a.frames {
+		img := image.NewPaletted(image.Rect(0, 0, width*cellSize, height*cellSize), palette)
+		for j := range img.Pix {
+			img.Pix[j] = indexes[Background]
+		}
+		offsetX := (width - frame.Width()) / 2 * cellSize
+		offsetY := (height - frame.Height()) / 2 * cellSize
+		frame.Each(func(p grid.Point, value byte) {
+			index := indexes[value]
+			for y := 0; y < cellSize; y++ {
+				start := img.PixOffset(offsetX+p.Column*cellSize, offsetY+p.Row*cellSize+y)
+				for x := 0; x < cellSize; x++ {
+					img.Pix[start+x] = index
+				}
+			}
+		})
+		images[i] = img
+	}
+
+	return images, nil
+}
+
+// getPalette returns the colors of all the characters used in the frames and the palette index of each character
+func (a *Animation) getPalette() (color.Palette, map[byte]uint8, error) {
+	used := map[byte]struct{}{Background: {}}
+	for _, frame := range a.frames {
+		frame.Each(func(_ grid.Point, value byte) {
+			used[value] = struct{}{}
+		})
+	}
+	if len(used) > 256 {
+		return nil, nil, fmt.Errorf("too many different cells (%d) for a palette of 256 colors", len(used))
+	}
+
+	palette := make(color.Palette, 0, len(used))
+	indexes := make(map[byte]uint8, len(used))
+	fallback := 0
+	for c := 0; c < 256; c++ { // ordered by the character, so the palette is the same for the same frames
+		if _, ok := used[byte(c)]; !ok {
+			continue
+		}
+		cellColor, ok := a.options.Colors[byte(c)]
+		if !ok {
+			cellColor, ok = DefaultColors[byte(c)]
+		}
+		if !ok {
+			cellColor = fallbackColors[fallback%len(fallbackColors)]
+			fallback++
+		}
+		indexes[byte(c)] = uint8(len(palette))
+		palette = append(palette, cellColor)
+	}
+
+	return palette, indexes, nil
+}
+
+// ParseColors reads the colors of the cells in format "#=202020,.=ffffff" (character=RGB hex)
+func ParseColors(definition string) (map[byte]color.Color, error) {
+	result := make(map[byte]color.Color)
+	if definition == "" {
+		return result, nil
+	}
+	for _, item := range strings.Split(definition, ",") {
+		character, hex, ok := strings.Cut(item, "=")
+		if !ok || len(character) != 1 {
+			return nil, fmt.Errorf("invalid color [%s], expected [<character>=<RRGGBB>]", item)
+		}
+		rgb, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+		if err != nil || len(strings.TrimPrefix(hex, "#")) != 6 {
+			return nil, fmt.Errorf("invalid color [%s], expected [<character>=<RRGGBB>]", item)
+		}
+		result[character[0]] = color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xff}
+	}
+
+	return result, nil
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
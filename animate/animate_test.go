@@ -0,0 +1,77 @@
+package animate
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+)
+
+func TestParseColors(t *testing.T) {
+	colors, err := ParseColors("#=ff0000,.=#00ff00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if colors['#'] != (color.RGBA{R: 0xff, A: 0xff}) || colors['.'] != (color.RGBA{G: 0xff, A: 0xff}) {
+		t.Errorf("ParseColors() = %v, want red # and green .", colors)
+	}
+
+	for _, invalid := range []string{"#", "##=ff0000", "#=red", "#=fff"} {
+		if _, err = ParseColors(invalid); err == nil {
+			t.Errorf("ParseColors(%q) did not fail", invalid)
+		}
+	}
+}
+
+func TestWriteGIF(t *testing.T) {
+	small, _ := grid.ParseBytes([]string{"#"}, "#.")
+	large, _ := grid.ParseBytes([]string{"#.#", "...", "#.L"}, "#.L")
+	animation := New(Options{CellSize: 2, Delay: 100 * time.Millisecond, Colors: map[byte]color.Color{'L': color.White}})
+	animation.Add(small)
+	animation.Add(large)
+
+	var buffer bytes.Buffer
+	if err := animation.WriteGIF(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := gif.DecodeAll(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Image) != 2 || decoded.Delay[0] != 10 {
+		t.Fatalf("GIF has %d frames with delay %d, want 2 frames with delay 10", len(decoded.Image), decoded.Delay[0])
+	}
+	for i, img := range decoded.Image {
+		if img.Bounds().Dx() != 6 || img.Bounds().Dy() != 6 {
+			t.Errorf("frame %d is %v, want 6x6 pixels", i, img.Bounds())
+		}
+	}
+
+	// the small frame is centered, the rest is the background
+	tests := []struct {
+		x, y int
+		want color.Color
+	}{
+		{0, 0, DefaultColors[Background]},
+		{2, 2, DefaultColors['#']},
+		{3, 3, DefaultColors['#']},
+		{4, 4, DefaultColors[Background]},
+	}
+	for _, tt := range tests {
+		if got := decoded.Image[0].At(tt.x, tt.y); !sameColor(got, tt.want) {
+			t.Errorf("pixel [%d, %d] = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+	if got := decoded.Image[1].At(5, 5); !sameColor(got, color.White) {
+		t.Errorf("configured color of L = %v, want white", got)
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
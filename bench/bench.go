@@ -0,0 +1,151 @@
+// Package bench measures the time and allocations of the puzzle solutions and compares them with a baseline
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// Stages of the solution measured separately
+var Stages = []string{"parse", "part1", "part2"}
+
+// Result is the average of all the runs of a single stage
+type Result struct {
+	Puzzle      string `json:"puzzle"`
+	Stage       string `json:"stage"`
+	Runs        int    `json:"runs"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	AllocsPerOp uint64 `json:"allocs_per_op"`
+	BytesPerOp  uint64 `json:"bytes_per_op"`
+}
+
+func (r Result) Duration() time.Duration {
+	return time.Duration(r.NsPerOp)
+}
+
+// Run measures all the stages of the puzzle, each one the given number of times
+func Run(puzzle solver.Puzzle, input []byte, runs int) ([]Result, error) {
+	if runs < 1 {
+		return nil, fmt.Errorf("number of runs must be positive, got [%d]", runs)
+	}
+
+	results := make([]Result, 0, len(Stages))
+	result, err := measure(puzzle, "parse", runs, func() error {
+		return puzzle.New().Parse(bytes.NewReader(input))
+	})
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, result)
+
+	s := puzzle.New()
+	if err = s.Parse(bytes.NewReader(input)); err != nil {
+		return nil, err
+	}
+	for part := 1; part <= 2; part++ {
+		result, err = measure(puzzle, Stages[part], runs, func() error {
+			_, err := solver.SolvePart(context.Background(), s, part)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func measure(puzzle solver.Puzzle, stage string, runs int, f func() error) (Result, error) {
+	runtime.GC() // do not count the garbage of the previous stages
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for i := 0; i < runs; i++ {
+		if err := f(); err != nil {
+			return Result{}, fmt.Errorf("%s %s failed: %w", puzzle, stage, err)
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return Result{
+		Puzzle:      puzzle.String(),
+		Stage:       stage,
+		Runs:        runs,
+		NsPerOp:     elapsed.Nanoseconds() / int64(runs),
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / uint64(runs),
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / uint64(runs),
+	}, nil
+}
+
+func Save(path string, results []Result) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func Load(path string) ([]Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, 0)
+	if err = json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("invalid benchmark results [%s]: %w", path, err)
+	}
+	return results, nil
+}
+
+// stages faster than this are too noisy to be compared by time
+const minComparedDuration = time.Millisecond
+
+// Regression is a stage which got slower (or allocates more) than the baseline allows
+type Regression struct {
+	Baseline Result
+	Current  Result
+	Reason   string
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s %s: %s", r.Current.Puzzle, r.Current.Stage, r.Reason)
+}
+
+// Compare finds the stages which are worse than the baseline by more than the threshold (0.2 = 20 %),
+// stages missing in the baseline are ignored
+func Compare(baseline []Result, current []Result, threshold float64) []Regression {
+	type key struct{ puzzle, stage string }
+	baselineMap := make(map[key]Result, len(baseline))
+	for _, result := range baseline {
+		baselineMap[key{result.Puzzle, result.Stage}] = result
+	}
+
+	regressions := make([]Regression, 0)
+	for _, result := range current {
+		base, ok := baselineMap[key{result.Puzzle, result.Stage}]
+		if !ok {
+			continue
+		}
+		if base.Duration() >= minComparedDuration && exceeds(float64(result.NsPerOp), float64(base.NsPerOp), threshold) {
+			regressions = append(regressions, Regression{base, result, fmt.Sprintf("time %s -> %s", base.Duration(), result.Duration())})
+		}
+		if exceeds(float64(result.AllocsPerOp), float64(base.AllocsPerOp), threshold) {
+			regressions = append(regressions, Regression{base, result, fmt.Sprintf("allocations %d -> %d", base.AllocsPerOp, result.AllocsPerOp)})
+		}
+	}
+
+	return regressions
+}
+
+func exceeds(current float64, baseline float64, threshold float64) bool {
+	return current > baseline*(1+threshold)
+}
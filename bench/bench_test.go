@@ -0,0 +1,32 @@
+package bench
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Puzzle: "2020/15", Stage: "part2", NsPerOp: int64(time.Second), AllocsPerOp: 10},
+		{Puzzle: "2020/01", Stage: "part1", NsPerOp: int64(time.Microsecond), AllocsPerOp: 3},
+	}
+
+	tests := []struct {
+		name    string
+		current Result
+		want    int
+	}{
+		{"within threshold", Result{Puzzle: "2020/15", Stage: "part2", NsPerOp: int64(1100 * time.Millisecond), AllocsPerOp: 10}, 0},
+		{"slower", Result{Puzzle: "2020/15", Stage: "part2", NsPerOp: int64(1500 * time.Millisecond), AllocsPerOp: 10}, 1},
+		{"slower and allocating more", Result{Puzzle: "2020/15", Stage: "part2", NsPerOp: int64(2 * time.Second), AllocsPerOp: 20}, 2},
+		{"too fast to compare the time", Result{Puzzle: "2020/01", Stage: "part1", NsPerOp: int64(10 * time.Microsecond), AllocsPerOp: 3}, 0},
+		{"missing in baseline", Result{Puzzle: "2020/02", Stage: "part1", NsPerOp: int64(time.Hour)}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(baseline, []Result{tt.current}, 0.2); len(got) != tt.want {
+				t.Errorf("got %d regressions %v, want %d", len(got), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Package cache stores the answers by the puzzle, the part, the input and the version of the solver, so the slow
+// parts do not need to be solved again for the same input
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// Key identifies a cached answer
+type Key struct {
+	Year      int    `json:"year"`
+	Day       int    `json:"day"`
+	Part      int    `json:"part"`
+	InputHash string `json:"input_sha256"`
+	Version   string `json:"version"` // version of the solver
+}
+
+// NewKey creates the key of the part of the puzzle solved by the given version for the given input
+func NewKey(puzzle solver.Puzzle, part int, input []byte, version string) Key {
+	hash := sha256.Sum256(input)
+	return Key{
+		Year:      puzzle.Year,
+		Day:       puzzle.Day,
+		Part:      part,
+		InputHash: hex.EncodeToString(hash[:]),
+		Version:   version,
+	}
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%d/%02d/%d/%s/%s", k.Year, k.Day, k.Part, k.InputHash, k.Version)
+}
+
+// Entry is a single cached answer
+type Entry struct {
+	Key
+	Answer   string         `json:"answer"`
+	Details  solver.Details `json:"details,omitempty"`
+	Duration time.Duration  `json:"duration_ns"` // how long it took to solve the part
+	Created  time.Time      `json:"created"`
+}
+
+// Cache of the answers stored as a JSON file, it is safe for concurrent use
+type Cache struct {
+	path    string
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+// Load reads the cache from the given file, a missing file means an empty cache
+func Load(path string) (*Cache, error) {
+	c := &Cache{path: path, entries: make(map[string]Entry)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0)
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid cache [%s], remove it to start over: %w", path, err)
+	}
+	for _, entry := range entries {
+		c.entries[entry.Key.String()] = entry
+	}
+	return c, nil
+}
+
+func (c *Cache) Save() error {
+	data, err := json.MarshalIndent(c.Entries(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0644)
+}
+
+// Get returns the cached result of the part, false if there is none
+func (c *Cache) Get(key Key) (solver.Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key.String()]
+	if !ok {
+		return solver.Result{}, false
+	}
+	return solver.Result{
+		Year:     entry.Year,
+		Day:      entry.Day,
+		Part:     entry.Part,
+		Answer:   entry.Answer,
+		Duration: entry.Duration,
+		Details:  entry.Details,
+		Cached:   true,
+	}, true
+}
+
+// Put stores the result of the part
+func (c *Cache) Put(key Key, result solver.Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key.String()] = Entry{
+		Key:      key,
+		Answer:   result.Answer,
+		Details:  result.Details,
+		Duration: result.Duration,
+		Created:  time.Now().UTC(),
+	}
+}
+
+// Entries returns all the cached answers ordered by the puzzle and the part (the newest ones first)
+func (c *Cache) Entries() []Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]Entry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch {
+		case a.Year != b.Year:
+			return a.Year < b.Year
+		case a.Day != b.Day:
+			return a.Day < b.Day
+		case a.Part != b.Part:
+			return a.Part < b.Part
+		default:
+			return a.Created.After(b.Created)
+		}
+	})
+	return result
+}
+
+// Clear removes the cached answers of the given puzzles (all of them if no puzzle is given), returns how many
+func (c *Cache) Clear(puzzles ...solver.Puzzle) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	removed := 0
+	for k, entry := range c.entries {
+		matches := len(puzzles) == 0
+		for _, puzzle := range puzzles {
+			matches = matches || (entry.Year == puzzle.Year && entry.Day == puzzle.Day)
+		}
+		if matches {
+			delete(c.entries, k)
+			removed++
+		}
+	}
+	return removed
+}
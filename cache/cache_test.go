@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "answers.json")
+	c, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	day15 := solver.Puzzle{Year: 2020, Day: 15}
+	day22 := solver.Puzzle{Year: 2020, Day: 22}
+	key := NewKey(day15, 2, []byte("0,3,6\n"), "1")
+	c.Put(key, solver.Result{Year: 2020, Day: 15, Part: 2, Answer: "175594", Duration: time.Second})
+	c.Put(NewKey(day22, 1, []byte("Player 1:\n"), "1"), solver.Result{Year: 2020, Day: 22, Part: 1, Answer: "306", Details: solver.Details{"winner": 2}})
+	if err = c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if c, err = Load(path); err != nil {
+		t.Fatal(err)
+	}
+	result, ok := c.Get(key)
+	if !ok || result.Answer != "175594" || result.Duration != time.Second || !result.Cached {
+		t.Errorf("Get() = %+v, %t, want the cached answer 175594", result, ok)
+	}
+	misses := []Key{
+		NewKey(day15, 1, []byte("0,3,6\n"), "1"),  // other part
+		NewKey(day15, 2, []byte("0,3,6,\n"), "1"), // other input
+		NewKey(day15, 2, []byte("0,3,6\n"), "2"),  // other version
+	}
+	for _, miss := range misses {
+		if _, ok = c.Get(miss); ok {
+			t.Errorf("Get(%s) found an answer of another key", miss)
+		}
+	}
+
+	if removed := c.Clear(day22); removed != 1 || len(c.Entries()) != 1 {
+		t.Errorf("Clear(2020/22) removed %d answers, %d left, want 1 removed and 1 left", removed, len(c.Entries()))
+	}
+	if removed := c.Clear(); removed != 1 || len(c.Entries()) != 0 {
+		t.Errorf("Clear() removed %d answers, %d left, want all removed", removed, len(c.Entries()))
+	}
+}
@@ -0,0 +1,154 @@
+// Package client talks to the Advent of Code website (or any server with the same API)
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultBaseURL = "https://adventofcode.com"
+
+// SessionEnv is the environment variable with the session cookie of the logged-in user
+const SessionEnv = "AOC_SESSION"
+
+// BaseURLEnv is the environment variable overriding the default base URL (e.g. a local fake server)
+const BaseURLEnv = "AOC_BASE_URL"
+
+const userAgent = "github.com/tomas-hanicinec/AdventOfCode_2020"
+
+// DefaultMinInterval is the minimal time between two requests, the website asks for being polite
+const DefaultMinInterval = 5 * time.Second
+
+// ErrNoSession is returned when a request needs the session token and there is none
+var ErrNoSession = fmt.Errorf("session token not set (use the %s environment variable)", SessionEnv)
+
+type Client struct {
+	BaseURL     string
+	Session     string
+	MinInterval time.Duration // rate limit between two requests
+	HTTPClient  *http.Client
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewClient creates a client with the base URL and session token read from the environment
+func NewClient() *Client {
+	baseURL := os.Getenv(BaseURLEnv)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL:     strings.TrimSuffix(baseURL, "/"),
+		Session:     os.Getenv(SessionEnv),
+		MinInterval: DefaultMinInterval,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// FetchInput downloads the puzzle input of the given day
+func (c *Client) FetchInput(year int, day int) ([]byte, error) {
+	request, err := c.newRequest(http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the input of [%d/%02d]: %w", year, day, err)
+	}
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("input of [%d/%02d] not found, the puzzle is probably not unlocked yet", year, day)
+	case response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusUnauthorized:
+		return nil, fmt.Errorf("input of [%d/%02d] refused (%s), the session token is probably invalid or expired", year, day, response.Status)
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected response for the input of [%d/%02d]: %s", year, day, response.Status)
+	}
+
+	return body, nil
+}
+
+// FetchInputToFile downloads the input to the given file unless it already exists (an empty file is only a placeholder
+// created by aoc new and it is replaced), returns true if it was downloaded
+func (c *Client) FetchInputToFile(year int, day int, path string) (bool, error) {
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+		return false, nil // already cached, never download again
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	input, err := c.FetchInput(year, day)
+	if err != nil {
+		return false, err
+	}
+
+	// write to a temporary file first so an interrupted download never leaves a truncated input in the cache
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(input); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if err = tmp.Close(); err != nil {
+		return false, err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *Client) newRequest(method string, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	request, err := http.NewRequest(method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	request.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	request.Header.Set("User-Agent", userAgent)
+
+	return request, nil
+}
+
+// do sends the request after waiting for the rate limit
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	if wait := c.MinInterval - time.Since(c.lastRequest); !c.lastRequest.IsZero() && wait > 0 {
+		time.Sleep(wait)
+	}
+	c.lastRequest = time.Now()
+	c.mu.Unlock()
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("request to [%s] failed: %w", request.URL, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newFakeServer serves the input of 2020/01 for the session "secret" and counts the requests
+func newFakeServer(t *testing.T, requests *int) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/2020/day/1/input" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("1721\n979\n"))
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:     server.URL,
+		Session:     "secret",
+		MinInterval: 0,
+		HTTPClient:  server.Client(),
+	}
+}
+
+func TestFetchInput(t *testing.T) {
+	requests := 0
+	c := newFakeServer(t, &requests)
+
+	input, err := c.FetchInput(2020, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(input) != "1721\n979\n" {
+		t.Errorf("unexpected input [%s]", input)
+	}
+
+	if _, err = c.FetchInput(2020, 2); err == nil {
+		t.Errorf("locked puzzle must fail")
+	}
+
+	c.Session = "wrong"
+	if _, err = c.FetchInput(2020, 1); err == nil {
+		t.Errorf("invalid session must fail")
+	}
+
+	c.Session = ""
+	if _, err = c.FetchInput(2020, 1); err != ErrNoSession {
+		t.Errorf("missing session must fail before the request, got [%v]", err)
+	}
+	if requests != 3 {
+		t.Errorf("server got %d requests, want 3", requests)
+	}
+}
+
+func TestFetchInputToFileCache(t *testing.T) {
+	requests := 0
+	c := newFakeServer(t, &requests)
+	path := filepath.Join(t.TempDir(), "inputs", "day_01.txt")
+
+	for i, wantDownloaded := range []bool{true, false} {
+		downloaded, err := c.FetchInputToFile(2020, 1, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if downloaded != wantDownloaded {
+			t.Errorf("fetch #%d: downloaded = %t, want %t", i+1, downloaded, wantDownloaded)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("cached input downloaded again (%d requests)", requests)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != "1721\n979\n" {
+		t.Errorf("unexpected cached input [%s] (%v)", data, err)
+	}
+}
+
+func TestFetchInputToFilePlaceholder(t *testing.T) {
+	requests := 0
+	c := newFakeServer(t, &requests)
+	path := filepath.Join(t.TempDir(), "day_01.txt")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	downloaded, err := c.FetchInputToFile(2020, 1, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !downloaded || requests != 1 {
+		t.Errorf("empty placeholder not replaced (downloaded = %t, %d requests)", downloaded, requests)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	requests := 0
+	c := newFakeServer(t, &requests)
+	c.MinInterval = 50 * time.Millisecond
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.FetchInput(2020, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*c.MinInterval {
+		t.Errorf("3 requests took only %s, the rate limit is %s", elapsed, c.MinInterval)
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Attempt is a single recorded answer submission
+type Attempt struct {
+	Puzzle  string    `json:"puzzle"` // e.g. "2020/07"
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// History of all the submitted answers, stored as a JSON file
+type History struct {
+	path     string
+	Attempts []Attempt
+}
+
+// LoadHistory reads the history from the given file, a missing file means an empty history
+func LoadHistory(path string) (*History, error) {
+	history := &History{path: path, Attempts: make([]Attempt, 0)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &history.Attempts); err != nil {
+		return nil, fmt.Errorf("invalid submission history [%s]: %w", path, err)
+	}
+
+	return history, nil
+}
+
+func (h *History) Save() error {
+	data, err := json.MarshalIndent(h.Attempts, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, append(data, '\n'), 0644)
+}
+
+func (h *History) Add(attempt Attempt) {
+	h.Attempts = append(h.Attempts, attempt)
+}
+
+// Check returns an error if the answer does not need to be submitted, because the part is already solved
+// or the answer is known to be wrong (including answers ruled out by the previous "too high" / "too low" verdicts)
+func (h *History) Check(puzzle string, part int, answer string) error {
+	number, numberErr := strconv.ParseInt(answer, 10, 64)
+	for _, attempt := range h.Attempts {
+		if attempt.Puzzle != puzzle || attempt.Part != part {
+			continue
+		}
+		if attempt.Outcome == Correct {
+			return fmt.Errorf("part %d of [%s] already solved with the answer [%s]", part, puzzle, attempt.Answer)
+		}
+		if attempt.Answer == answer && attempt.Outcome.IsWrong() {
+			return fmt.Errorf("answer [%s] for part %d of [%s] was already submitted and it is %s", answer, part, puzzle, attempt.Outcome)
+		}
+
+		previous, err := strconv.ParseInt(attempt.Answer, 10, 64)
+		if numberErr != nil || err != nil {
+			continue // not numbers, cannot compare
+		}
+		if attempt.Outcome == TooHigh && number >= previous {
+			return fmt.Errorf("answer [%s] for part %d of [%s] is too high, [%s] already was", answer, part, puzzle, attempt.Answer)
+		}
+		if attempt.Outcome == TooLow && number <= previous {
+			return fmt.Errorf("answer [%s] for part %d of [%s] is too low, [%s] already was", answer, part, puzzle, attempt.Answer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestHistoryCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "submissions.json")
+	history, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history.Add(Attempt{Puzzle: "2020/01", Part: 1, Answer: "100", Outcome: TooHigh})
+	history.Add(Attempt{Puzzle: "2020/01", Part: 1, Answer: "10", Outcome: TooLow})
+	history.Add(Attempt{Puzzle: "2020/01", Part: 2, Answer: "abc", Outcome: Wrong})
+	history.Add(Attempt{Puzzle: "2020/01", Part: 2, Answer: "xyz", Outcome: Wait})
+	history.Add(Attempt{Puzzle: "2020/02", Part: 1, Answer: "5", Outcome: Correct})
+	if err = history.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if history, err = LoadHistory(path); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		puzzle  string
+		part    int
+		answer  string
+		allowed bool
+	}{
+		{"2020/01", 1, "50", true},
+		{"2020/01", 1, "100", false},
+		{"2020/01", 1, "150", false},
+		{"2020/01", 1, "5", false},
+		{"2020/01", 2, "abc", false},
+		{"2020/01", 2, "xyz", true}, // not evaluated, can be submitted again
+		{"2020/02", 1, "6", false},
+		{"2020/02", 2, "6", true},
+	}
+	for _, tt := range tests {
+		err := history.Check(tt.puzzle, tt.part, tt.answer)
+		if tt.allowed && err != nil {
+			t.Errorf("%s part %d [%s] must be allowed, got [%s]", tt.puzzle, tt.part, tt.answer, err)
+		}
+		if !tt.allowed && err == nil {
+			t.Errorf("%s part %d [%s] must not be allowed", tt.puzzle, tt.part, tt.answer)
+		}
+	}
+}
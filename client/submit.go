@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the verdict of the website about the submitted answer
+type Outcome string
+
+const (
+	Correct       Outcome = "correct"
+	Wrong         Outcome = "wrong"
+	TooHigh       Outcome = "too high"
+	TooLow        Outcome = "too low"
+	Wait          Outcome = "wait"           // answer submitted too recently, not evaluated at all
+	AlreadySolved Outcome = "already solved" // the part is already completed (or not unlocked yet)
+	Unknown       Outcome = "unknown"
+)
+
+// IsWrong is true for all the outcomes which mean the answer is not correct
+func (o Outcome) IsWrong() bool {
+	return o == Wrong || o == TooHigh || o == TooLow
+}
+
+type Verdict struct {
+	Outcome Outcome
+	Wait    time.Duration // how long to wait before the next submission (if known)
+	Message string        // plain text of the response
+}
+
+// Submit posts the answer for the given part of the puzzle and parses the response
+func (c *Client) Submit(year int, day int, part int, answer string) (Verdict, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+	request, err := c.newRequest(http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	response, err := c.do(request)
+	if err != nil {
+		return Verdict{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("failed to read the response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("unexpected response for the answer of [%d/%02d] part %d: %s", year, day, part, response.Status)
+	}
+
+	return ParseVerdict(string(body)), nil
+}
+
+var articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+var waitPattern = regexp.MustCompile(`(?:(\d+)m )?(\d+)s left to wait`)
+var waitMinutesPattern = regexp.MustCompile(`wait (one|\d+) minutes?`)
+
+// ParseVerdict reads the outcome from the HTML response of the answer submission
+func ParseVerdict(body string) Verdict {
+	message := body
+	if matches := articlePattern.FindStringSubmatch(body); matches != nil {
+		message = matches[1] // the message is in the only <article> of the page
+	}
+	message = html.UnescapeString(tagPattern.ReplaceAllString(message, ""))
+	message = strings.Join(strings.Fields(message), " ")
+
+	verdict := Verdict{
+		Outcome: Unknown,
+		Message: message,
+	}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		verdict.Outcome = Correct
+	case strings.Contains(message, "That's not the right answer"):
+		verdict.Outcome = Wrong
+		if strings.Contains(message, "your answer is too high") {
+			verdict.Outcome = TooHigh
+		} else if strings.Contains(message, "your answer is too low") {
+			verdict.Outcome = TooLow
+		}
+		if matches := waitMinutesPattern.FindStringSubmatch(message); matches != nil {
+			minutes := 1 // "one minute"
+			if matches[1] != "one" {
+				minutes, _ = strconv.Atoi(matches[1])
+			}
+			verdict.Wait = time.Duration(minutes) * time.Minute
+		}
+	case strings.Contains(message, "You gave an answer too recently"):
+		verdict.Outcome = Wait
+		if matches := waitPattern.FindStringSubmatch(message); matches != nil {
+			minutes, _ := strconv.Atoi(matches[1]) // optional, zero if missing
+			seconds, _ := strconv.Atoi(matches[2])
+			verdict.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		verdict.Outcome = AlreadySolved
+	}
+
+	return verdict
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	correctResponse = `<html><body><main><article><p>That's the right answer!  You are one gold star closer to saving your vacation. <a href="/2020/day/1#part2">[Continue to Part Two]</a></p></article></main></body></html>`
+	tooHighResponse = `<html><body><main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2020/day/1">[Return to Day 1]</a></p></article></main></body></html>`
+	tooLowResponse  = `<article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.</p></article>`
+	wrongResponse   = `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`
+	waitResponse    = `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 12s left to wait. <a href="/2020/day/1">[Return to Day 1]</a></p></article>`
+	solvedResponse  = `<article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2020/day/1">[Return to Day 1]</a></p></article>`
+	unknownResponse = `<html><body>Something else</body></html>`
+)
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantOutcome Outcome
+		wantWait    time.Duration
+	}{
+		{"correct", correctResponse, Correct, 0},
+		{"too high", tooHighResponse, TooHigh, time.Minute},
+		{"too low", tooLowResponse, TooLow, 5 * time.Minute},
+		{"wrong", wrongResponse, Wrong, 0},
+		{"wait", waitResponse, Wait, 72 * time.Second},
+		{"already solved", solvedResponse, AlreadySolved, 0},
+		{"unknown", unknownResponse, Unknown, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict := ParseVerdict(tt.body)
+			if verdict.Outcome != tt.wantOutcome {
+				t.Errorf("outcome = [%s], want [%s] (message [%s])", verdict.Outcome, tt.wantOutcome, verdict.Message)
+			}
+			if verdict.Wait != tt.wantWait {
+				t.Errorf("wait = %s, want %s", verdict.Wait, tt.wantWait)
+			}
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2020/day/1/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("level") == "1" && r.FormValue("answer") == "514579" {
+			_, _ = w.Write([]byte(correctResponse))
+			return
+		}
+		_, _ = w.Write([]byte(tooHighResponse))
+	}))
+	defer server.Close()
+	c := &Client{BaseURL: server.URL, Session: "secret", HTTPClient: server.Client()}
+
+	tests := []struct {
+		part   int
+		answer string
+		want   Outcome
+	}{
+		{1, "514579", Correct},
+		{1, "999999", TooHigh},
+		{2, "514579", TooHigh},
+	}
+	for _, tt := range tests {
+		verdict, err := c.Submit(2020, 1, tt.part, tt.answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if verdict.Outcome != tt.want {
+			t.Errorf("part %d answer [%s]: outcome = [%s], want [%s]", tt.part, tt.answer, verdict.Outcome, tt.want)
+		}
+	}
+
+	if _, err := c.Submit(2020, 2, 1, "1"); err == nil {
+		t.Errorf("unexpected response status must fail")
+	}
+}
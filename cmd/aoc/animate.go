@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/animate"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// startAnimation makes the solver record the iterations of the given parts, the returned function saves them to the path
+// (with the part number added to the file name when animating both parts)
+func startAnimation(puzzle solver.Puzzle, s solver.Solver, parts []int, path string, options animate.Options) (func() error, error) {
+	animator, ok := s.(solver.Animator)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support animation", puzzle)
+	}
+	animations := make(map[int]*animate.Animation, len(parts))
+	for _, part := range parts {
+		animations[part] = animate.New(options)
+	}
+	animator.Animate(func(part int, frame *grid.Grid[byte]) {
+		if animation, ok := animations[part]; ok {
+			animation.Add(frame)
+		}
+	})
+
+	save := func() error {
+		for _, part := range parts {
+			if animations[part].Len() == 0 {
+				continue // nothing iterated in this part
+			}
+			if err := animations[part].Save(partPath(path, part, parts)); err != nil {
+				return fmt.Errorf("%s: failed to save the animation of part %d: %w", puzzle, part, err)
+			}
+		}
+		return nil
+	}
+
+	return save, nil
+}
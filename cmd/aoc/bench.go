@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/bench"
+)
+
+func benchCommand(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzles")
+	runs := flags.Int("runs", 1, "number of runs of every stage (the average is reported)")
+	out := flags.String("out", "", "write the results as JSON to the given file")
+	baselinePath := flags.String("baseline", "", "compare the results with the JSON results of a previous run")
+	threshold := flags.Float64("threshold", 0.2, "allowed slowdown against the baseline (0.2 = 20 %)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	puzzles, err := getPuzzles(*year, positional)
+	if err != nil {
+		return err
+	}
+	var baseline []bench.Result
+	if *baselinePath != "" {
+		if baseline, err = bench.Load(*baselinePath); err != nil {
+			return err
+		}
+	}
+
+	results := make([]bench.Result, 0, len(puzzles)*len(bench.Stages))
+	fmt.Printf("%-8s %-6s %14s %12s %14s\n", "puzzle", "stage", "time", "allocs", "bytes")
+	for _, puzzle := range puzzles {
+		input, err := os.ReadFile(filepath.Join(inputsDir, puzzle.InputFile()))
+		if err != nil {
+			return err
+		}
+		puzzleResults, err := bench.Run(puzzle, input, *runs)
+		if err != nil {
+			return err
+		}
+		for _, result := range puzzleResults {
+			fmt.Printf("%-8s %-6s %14s %12d %14d\n", result.Puzzle, result.Stage, result.Duration().Round(time.Microsecond), result.AllocsPerOp, result.BytesPerOp)
+		}
+		results = append(results, puzzleResults...)
+	}
+
+	if *out != "" {
+		if err = bench.Save(*out, results); err != nil {
+			return err
+		}
+	}
+	if baseline == nil {
+		return nil
+	}
+	regressions := bench.Compare(baseline, results, *threshold)
+	for _, regression := range regressions {
+		fmt.Printf("REGRESSION %s\n", regression)
+	}
+	if len(regressions) > 0 {
+		return fmt.Errorf("%d regression(s) over the threshold of %.0f %%", len(regressions), *threshold*100)
+	}
+	fmt.Printf("no regressions over the threshold of %.0f %%\n", *threshold*100)
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/cache"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func cacheCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("cache subcommand expected (ls or clear)")
+	}
+	flags := flag.NewFlagSet("cache "+args[0], flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzles")
+	positional, err := parseArgs(flags, args[1:])
+	if err != nil {
+		return err
+	}
+	answers, err := cache.Load(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "ls":
+		if len(positional) != 0 {
+			return fmt.Errorf("no arguments expected, got %d", len(positional))
+		}
+		return listCache(answers)
+	case "clear":
+		puzzles := make([]solver.Puzzle, 0)
+		if len(positional) > 0 {
+			if puzzles, err = getPuzzles(*year, positional); err != nil {
+				return err
+			}
+		}
+		removed := answers.Clear(puzzles...)
+		if err = answers.Save(); err != nil {
+			return err
+		}
+		fmt.Printf("%d cached answer(s) removed\n", removed)
+		return nil
+	default:
+		return fmt.Errorf("unknown cache subcommand [%s], must be ls or clear", args[0])
+	}
+}
+
+func listCache(answers *cache.Cache) error {
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "puzzle\tpart\tversion\tinput\tanswer\ttime\tcreated")
+	for _, entry := range answers.Entries() {
+		puzzle := solver.Puzzle{Year: entry.Year, Day: entry.Day}
+		fmt.Fprintf(table, "%s\t%d\t%s\t%.12s\t%s\t%s\t%s\n", puzzle, entry.Part, entry.Version, entry.InputHash, entry.Answer,
+			entry.Duration.Round(time.Microsecond), entry.Created.Local().Format(time.DateTime))
+	}
+	return table.Flush()
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/verify"
+)
+
+func crosscheckCommand(args []string) error {
+	flags := flag.NewFlagSet("crosscheck", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzles")
+	seeds := flags.Int("seeds", 20, "number of the generated inputs of each puzzle")
+	firstSeed := flags.Int64("seed", 1, "seed of the first generated input, the next ones get the following seeds")
+	// the references are naive, a generated input too large for them must not hang the check
+	timeout := flags.Duration("timeout", time.Minute, "stop each puzzle after the given time (0 = no limit)")
+	params := make(paramsFlag)
+	flags.Var(params, "set", "set a parameter of the generator, e.g. --set buses=3 (can be repeated)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if *seeds < 1 {
+		return fmt.Errorf("at least one seed expected, got %d", *seeds)
+	}
+	puzzles, err := getPuzzles(*year, positional)
+	if err != nil {
+		return err
+	}
+	seedList := make([]int64, *seeds)
+	for i := range seedList {
+		seedList[i] = *firstSeed + int64(i)
+	}
+
+	failed := 0
+	for _, puzzle := range puzzles {
+		if _, ok := puzzle.Reference(); !ok && len(positional) == 0 {
+			continue // only the puzzles with a reference solver are checked when no day is given
+		}
+		ctx, cancel := solveContext(*timeout)
+		disagreements, err := verify.Differential(ctx, puzzle, seedList, solver.Params(params))
+		cancel()
+		if err != nil {
+			return err
+		}
+		if len(disagreements) == 0 {
+			fmt.Printf("%s: ok (%d inputs)\n", puzzle, len(seedList))
+			continue
+		}
+		for _, disagreement := range disagreements {
+			failed++
+			fmt.Printf("%s  repro: %s\n", disagreement, reproCommand(disagreement))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d part(s) disagree", failed)
+	}
+	return nil
+}
+
+// reproCommand is the command generating the input of the disagreement
+func reproCommand(d verify.Disagreement) string {
+	command := fmt.Sprintf("aoc gen %d --year %d --seed %d", d.Puzzle.Day, d.Puzzle.Year, d.Seed)
+	if len(d.Params) > 0 {
+		command += " --set " + strings.ReplaceAll(d.Params.String(), ",", " --set ")
+	}
+	return command
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/client"
+)
+
+func fetchCommand(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzles")
+	baseURL := flags.String("base-url", "", "base URL of the website (default from "+client.BaseURLEnv+" or "+client.DefaultBaseURL+")")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return fmt.Errorf("at least one day expected")
+	}
+	puzzles, err := getPuzzles(*year, positional)
+	if err != nil {
+		return err
+	}
+
+	c := client.NewClient()
+	if *baseURL != "" {
+		c.BaseURL = strings.TrimSuffix(*baseURL, "/")
+	}
+	for _, puzzle := range puzzles {
+		path := filepath.Join(inputsDir, puzzle.InputFile())
+		downloaded, err := c.FetchInputToFile(puzzle.Year, puzzle.Day, path)
+		if err != nil {
+			return err
+		}
+		if downloaded {
+			fmt.Printf("%s: downloaded to %s\n", puzzle, path)
+		} else {
+			fmt.Printf("%s: %s already exists, skipped\n", puzzle, path)
+		}
+	}
+	return nil
+}
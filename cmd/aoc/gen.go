@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func genCommand(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzle")
+	seed := flags.Int64("seed", 0, "seed of the random generator, the same seed gives the same input (random by default)")
+	out := flags.String("out", "", "write the input to the file instead of stdout")
+	showParams := flags.Bool("params", false, "list the parameters of the generator and their default values")
+	params := make(paramsFlag)
+	flags.Var(params, "set", "set a parameter of the generator, e.g. --set tiles=16 (can be repeated)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("exactly one day expected, got %d arguments", len(positional))
+	}
+	puzzles, err := getPuzzles(*year, positional)
+	if err != nil {
+		return err
+	}
+	puzzle := puzzles[0]
+
+	if *showParams {
+		generator, ok := puzzle.Generator()
+		if !ok {
+			return fmt.Errorf("puzzle [%s] has no input generator", puzzle)
+		}
+		fmt.Println(generator.Defaults)
+		return nil
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+		fmt.Fprintf(os.Stderr, "%s: seed %d\n", puzzle, *seed) // to be able to generate the same input again
+	}
+	input, err := puzzle.Generate(*seed, solver.Params(params))
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = fmt.Print(input)
+		return err
+	}
+	return os.WriteFile(*out, []byte(input), 0644)
+}
+
+// paramsFlag collects the repeated --set name=value flags
+type paramsFlag solver.Params
+
+func (pf paramsFlag) String() string {
+	return solver.Params(pf).String()
+}
+
+func (pf paramsFlag) Set(value string) error {
+	name, number, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("parameter must be in format [name=value]")
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return fmt.Errorf("parameter [%s] must be a number", name)
+	}
+	pf[name] = n
+	return nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+const defaultYear = 2020
+const inputsDir = "inputs"
+const answersFile = "inputs/answers.json"
+const cacheFile = "inputs/cache.json"
+
+const usage = `Usage:
+  aoc run <day> [--year 2020] [--part 1|2] [--input <path>|- | --example <name>] [--format text|json|csv]
+         [--animate out.gif|out.png] [--cell-size 4] [--colors "#=202020,.=ffffff"] [--delay 200ms]
+         [--timeout 10s] [--progress] [--no-cache]
+         [--cpuprofile cpu.out] [--memprofile mem.out] [--trace trace.out] [--profile-part parse|1|2]
+                                              run the solution of the given day (both parts by default)
+  aoc run --all [--year 2020] [-j N] [--part 1|2] [--timeout 10s] [--format text|json|csv] [--no-cache]
+                                              run all the days in parallel and print a summary table
+  aoc verify [<day>...] [--year 2020] [--timeout 10s]
+                                              check the answers for the inputs against inputs/answers.json
+  aoc bench [<day>...] [--year 2020] [--runs N] [--out results.json] [--baseline results.json] [--threshold 0.2]
+                                              measure the time and allocations of parsing and both parts
+  aoc fetch <day>... [--year 2020] [--base-url URL]
+                                              download the inputs to inputs/ (the session token is read from AOC_SESSION)
+  aoc submit <day> <part> [--year 2020] [--input <path>|-] [--base-url URL] [--history inputs/submissions.json] [--timeout 10s]
+                                              solve the part and submit the answer (never the same wrong one twice)
+  aoc gen <day> [--year 2020] [--seed N] [--set name=value]... [--params] [--out <path>]
+                                              generate a random valid input (same seed, same input)
+  aoc crosscheck [<day>...] [--year 2020] [--seeds 20] [--seed 1] [--set name=value]... [--timeout 1m]
+                                              compare the optimized and the reference solvers on generated inputs
+  aoc serve [--addr localhost:8080] [--max-input 1048576] [--timeout 30s] [--workers N] [--max-uninterruptible N]
+                                              serve the solutions over HTTP: POST /2020/day/<day>/part/<part> with the input
+  aoc cache ls                                list the cached answers
+  aoc cache clear [<day>...] [--year 2020]    remove the cached answers (of the given days)
+  aoc new <year> <day>                        create the skeleton of a new day and register it
+  aoc list                                    list all the registered solutions and their examples
+
+The input is read from inputs/day_<day>.txt by default, --input - reads it from stdin.
+All the commands accept --log-level trace|debug|info|warn|error, the logs are written to stderr.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(os.Args[2:])
+	case "verify":
+		err = verifyCommand(os.Args[2:])
+	case "bench":
+		err = benchCommand(os.Args[2:])
+	case "fetch":
+		err = fetchCommand(os.Args[2:])
+	case "submit":
+		err = submitCommand(os.Args[2:])
+	case "gen":
+		err = genCommand(os.Args[2:])
+	case "crosscheck":
+		err = crosscheckCommand(os.Args[2:])
+	case "serve":
+		err = serveCommand(os.Args[2:])
+	case "cache":
+		err = cacheCommand(os.Args[2:])
+	case "new":
+		err = newCommand(os.Args[2:])
+	case "list":
+		err = listCommand()
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+	default:
+		err = fmt.Errorf("unknown command [%s]", os.Args[1])
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aoc: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// getPuzzles returns the puzzles of the given days, all the puzzles of the year if no day is given
+func getPuzzles(year int, days []string) ([]solver.Puzzle, error) {
+	if len(days) == 0 {
+		result := make([]solver.Puzzle, 0)
+		for _, puzzle := range solver.All() {
+			if puzzle.Year == year {
+				result = append(result, puzzle)
+			}
+		}
+		return result, nil
+	}
+
+	result := make([]solver.Puzzle, len(days))
+	for i, dayString := range days {
+		day, err := strconv.Atoi(dayString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid day [%s]", dayString)
+		}
+		if result[i], err = solver.Get(year, day); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func listCommand() error {
+	for _, puzzle := range solver.All() {
+		examples := puzzle.ExampleNames()
+		if len(examples) == 0 {
+			fmt.Println(puzzle)
+			continue
+		}
+		fmt.Printf("%s    examples: %s\n", puzzle, strings.Join(examples, ", "))
+	}
+	return nil
+}
+
+// setupLogging makes the default logger write the logs of the given level (and above) to stderr
+func setupLogging(level string) error {
+	var minLevel slog.Level
+	if strings.EqualFold(level, "trace") {
+		minLevel = solver.LevelTrace
+	} else if err := minLevel.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level [%s], must be one of [trace, debug, info, warn, error]", level)
+	}
+	options := &slog.HandlerOptions{
+		Level: minLevel,
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.LevelKey && attr.Value.Any() == solver.LevelTrace {
+				attr.Value = slog.StringValue("TRACE") // instead of DEBUG-4
+			}
+			return attr
+		},
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, options)))
+	return nil
+}
+
+// parseArgs parses flags interleaved with positional arguments (the standard flag package stops at the first non-flag),
+// it also adds the --log-level flag common to all the commands and sets up the logger
+func parseArgs(flags *flag.FlagSet, args []string) ([]string, error) {
+	logLevel := flags.String("log-level", "info", "minimal level of the logs written to stderr (trace, debug, info, warn, error)")
+	positional := make([]string, 0)
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, setupLogging(*logLevel)
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/scaffold"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func newCommand(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ContinueOnError)
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("year and day expected, got %d arguments", len(positional))
+	}
+	year, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid year [%s]", positional[0])
+	}
+	day, err := strconv.Atoi(positional[1])
+	if err != nil {
+		return fmt.Errorf("invalid day [%s]", positional[1])
+	}
+
+	changed, err := scaffold.Create(".", year, day)
+	for _, path := range changed {
+		fmt.Println(path)
+	}
+	if err != nil {
+		return err
+	}
+	puzzle := solver.Puzzle{Year: year, Day: day}
+	d := scaffold.Day{Year: year, Day: day}
+	fmt.Printf("%s: paste the input to %s (or run aoc fetch %d --year %d) and the example from the description to %s\n",
+		puzzle, filepath.Join(inputsDir, puzzle.InputFile()), day, year, filepath.Join(d.YearPackage(), d.Package(), "examples", "example.txt"))
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+var formats = []string{"text", "json", "csv"}
+
+func validateFormat(format string) error {
+	for _, f := range formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown format [%s], must be one of [%s]", format, strings.Join(formats, ", "))
+}
+
+// writeResults prints the results in the given format (text, json or csv)
+func writeResults(w io.Writer, format string, results []solver.Result) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	case "csv":
+		return writeCSV(w, results)
+	default:
+		for _, result := range results {
+			line := fmt.Sprintf("%d/%02d part %d: %s", result.Year, result.Day, result.Part, result.Answer)
+			if len(result.Details) > 0 {
+				line += " (" + formatDetails(result.Details) + ")"
+			}
+			if result.Cached {
+				line += " [cached]"
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func writeCSV(w io.Writer, results []solver.Result) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"year", "day", "part", "answer", "duration_ms", "details", "cached"}); err != nil {
+		return err
+	}
+	for _, result := range results {
+		details := ""
+		if len(result.Details) > 0 {
+			data, err := json.Marshal(result.Details)
+			if err != nil {
+				return err
+			}
+			details = string(data)
+		}
+		record := []string{
+			strconv.Itoa(result.Year),
+			strconv.Itoa(result.Day),
+			strconv.Itoa(result.Part),
+			result.Answer,
+			strconv.FormatFloat(float64(result.Duration.Microseconds())/1000, 'f', 3, 64),
+			details,
+			strconv.FormatBool(result.Cached),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatDetails prints the details as key=value pairs ordered by the key
+func formatDetails(details solver.Details) string {
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", key, details[key])
+	}
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+)
+
+// profileOptions are the output files of the profiles (empty ones are not recorded)
+type profileOptions struct {
+	cpuProfile string
+	memProfile string
+	trace      string
+}
+
+func (o profileOptions) enabled() bool {
+	return o.cpuProfile != "" || o.memProfile != "" || o.trace != ""
+}
+
+// parseStage is the profiled stage of parsing the input, the other stages are the part numbers
+const parseStage = "parse"
+
+// stageSuffix is added to the file names when profiling more stages, e.g. cpu.out -> cpu_parse.out, cpu_part1.out
+func stageSuffix(stage string, stages []string) string {
+	if len(stages) < 2 {
+		return ""
+	}
+	if stage == parseStage {
+		return "_parse"
+	}
+	return "_part" + stage
+}
+
+// startProfiling starts the CPU profile and the trace, the returned function stops them and writes the memory profile
+// (the suffix is added to the file names); the heap profiles count the allocations since the start of the program,
+// so a base memory profile is written at the start of the stage too and the command comparing them is printed
+func startProfiling(options profileOptions, suffix string) (func() error, error) {
+	memBase := addSuffix(options.memProfile, suffix+"_base")
+	if options.memProfile != "" {
+		if err := writeMemProfile(memBase); err != nil {
+			return nil, err
+		}
+	}
+
+	files := make([]*os.File, 0, 2)
+	closeAll := func() error {
+		var result error
+		for _, file := range files {
+			if err := file.Close(); err != nil && result == nil {
+				result = err
+			}
+		}
+		return result
+	}
+	create := func(path string) (*os.File, error) {
+		file, err := os.Create(addSuffix(path, suffix))
+		if err == nil {
+			files = append(files, file)
+		}
+		return file, err
+	}
+
+	if options.cpuProfile != "" {
+		file, err := create(options.cpuProfile)
+		if err != nil {
+			return nil, err
+		}
+		if err = pprof.StartCPUProfile(file); err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to start the CPU profile: %w", err)
+		}
+	}
+	if options.trace != "" {
+		file, err := create(options.trace)
+		if err == nil {
+			err = trace.Start(file)
+		}
+		if err != nil {
+			if options.cpuProfile != "" {
+				pprof.StopCPUProfile()
+			}
+			closeAll()
+			return nil, fmt.Errorf("failed to start the trace: %w", err)
+		}
+	}
+
+	stop := func() error {
+		if options.cpuProfile != "" {
+			pprof.StopCPUProfile()
+		}
+		if options.trace != "" {
+			trace.Stop()
+		}
+		if err := closeAll(); err != nil {
+			return err
+		}
+		if options.memProfile == "" {
+			return nil
+		}
+		memProfile := addSuffix(options.memProfile, suffix)
+		if err := writeMemProfile(memProfile); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "allocations of the stage: go tool pprof -base %s %s\n", memBase, memProfile)
+		return nil
+	}
+	return stop, nil
+}
+
+// writeMemProfile writes the heap profile with the allocations up to now
+func writeMemProfile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	runtime.GC() // up-to-date statistics of the live objects
+	if err = pprof.WriteHeapProfile(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write the memory profile: %w", err)
+	}
+	return file.Close()
+}
+
+// partPath adds the part number to the file name when there are more parts, e.g. out.gif -> out_part1.gif
+func partPath(path string, part int, parts []int) string {
+	if len(parts) < 2 {
+		return path
+	}
+	return addSuffix(path, fmt.Sprintf("_part%d", part))
+}
+
+// addSuffix adds the suffix to the file name before the extension
+func addSuffix(path string, suffix string) string {
+	extension := filepath.Ext(path)
+	return strings.TrimSuffix(path, extension) + suffix + extension
+}
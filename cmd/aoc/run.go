@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/animate"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/cache"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/progress"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+func runCommand(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzle")
+	part := flags.Int("part", 0, "run only the given part (1 or 2)")
+	all := flags.Bool("all", false, "run all the days of the year in parallel and print a summary")
+	workers := flags.Int("j", runtime.NumCPU(), "number of days solved at the same time (with --all)")
+	inputPath := flags.String("input", "", "path to the puzzle input, - for stdin")
+	example := flags.String("example", "", "name of the built-in example to use as the input")
+	format := flags.String("format", "text", "output format ("+strings.Join(formats, ", ")+")")
+	animatePath := flags.String("animate", "", "save the iterations as an animated GIF (.gif) or PNG frames (.png)")
+	cellSize := flags.Int("cell-size", 4, "size of a cell of the animation in pixels")
+	colors := flags.String("colors", "", "colors of the animated cells, e.g. \"#=202020,.=ffffff\"")
+	delay := flags.Duration("delay", 200*time.Millisecond, "how long each frame of the GIF is shown")
+	timeout := flags.Duration("timeout", 0, "stop the solution after the given time (0 = no limit)")
+	noCache := flags.Bool("no-cache", false, "solve the parts again even if their answers are cached")
+	showProgress := flags.Bool("progress", progress.IsTerminal(os.Stderr), "show the progress of the long-running parts on stderr")
+	var profiling profileOptions
+	flags.StringVar(&profiling.cpuProfile, "cpuprofile", "", "write the CPU profile of the parsing and the solved parts to the file")
+	flags.StringVar(&profiling.memProfile, "memprofile", "", "write the memory profile after the parsing and the solved parts to the file")
+	flags.StringVar(&profiling.trace, "trace", "", "write the execution trace of the parsing and the solved parts to the file")
+	profilePart := flags.String("profile-part", "", "profile only the given stage (parse, 1 or 2), the parsing and all the solved parts by default")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	cellColors, err := animate.ParseColors(*colors)
+	if err != nil {
+		return err
+	}
+	if err = validateFormat(*format); err != nil {
+		return err
+	}
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+	profiledStages := []string{parseStage}
+	for _, p := range parts {
+		profiledStages = append(profiledStages, strconv.Itoa(p))
+	}
+	if *profilePart != "" {
+		if !slices.Contains(profiledStages, *profilePart) {
+			return fmt.Errorf("--profile-part %s is not run, must be one of [%s]", *profilePart, strings.Join(profiledStages, ", "))
+		}
+		profiledStages = []string{*profilePart}
+	}
+	if *all {
+		if len(positional) > 0 || *inputPath != "" || *example != "" || *animatePath != "" || profiling.enabled() {
+			return fmt.Errorf("--all cannot be used with a day, --input, --example, --animate or profiling")
+		}
+		ctx, cancel := solveContext(0) // the timeout applies to each day separately
+		defer cancel()
+		return runAll(ctx, *year, parts, *workers, *timeout, *format, !*noCache)
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("exactly one day expected, got %d arguments", len(positional))
+	}
+	day, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid day [%s]", positional[0])
+	}
+
+	puzzle, err := solver.Get(*year, day)
+	if err != nil {
+		return err
+	}
+	input, inputName, err := readInput(puzzle, *inputPath, *example)
+	if err != nil {
+		return err
+	}
+	var answers *cache.Cache
+	version, versioned := puzzle.Version()
+	if !*noCache && *animatePath == "" && !profiling.enabled() && versioned {
+		if answers, err = cache.Load(cacheFile); err != nil {
+			return err
+		}
+	}
+	s := puzzle.New()
+	parsed := false
+	saveAnimation := func() error { return nil }
+	if *animatePath != "" {
+		options := animate.Options{CellSize: *cellSize, Delay: *delay, Colors: cellColors}
+		if saveAnimation, err = startAnimation(puzzle, s, parts, *animatePath, options); err != nil {
+			return err
+		}
+	}
+	// profile runs the stage, recording the profiles if it is profiled
+	profile := func(stage string, run func() error) error {
+		if !profiling.enabled() || !slices.Contains(profiledStages, stage) {
+			return run()
+		}
+		stopProfiling, err := startProfiling(profiling, stageSuffix(stage, profiledStages))
+		if err != nil {
+			return err
+		}
+		runErr := run()
+		if err = stopProfiling(); err != nil {
+			return fmt.Errorf("%s: profiling of stage %s failed: %w", puzzle, stage, err)
+		}
+		return runErr
+	}
+	ctx, cancel := solveContext(*timeout)
+	defer cancel()
+	results := make([]solver.Result, 0, len(parts))
+	for _, p := range parts {
+		key := cache.NewKey(puzzle, p, input, version)
+		if answers != nil {
+			if result, ok := answers.Get(key); ok {
+				results = append(results, result)
+				continue
+			}
+		}
+		if !parsed {
+			if err = profile(parseStage, func() error { return parse(puzzle, s, input, inputName) }); err != nil {
+				return err
+			}
+			parsed = true
+		}
+		stopProgress := func() {}
+		if *showProgress {
+			stopProgress = startProgress(puzzle, s, p)
+		}
+		var result solver.Result
+		err = profile(strconv.Itoa(p), func() error {
+			var solveErr error
+			result, solveErr = solver.Solve(ctx, puzzle, s, p)
+			if solveErr != nil {
+				return fmt.Errorf("%s: part %d failed: %w", puzzle, p, solveErr)
+			}
+			return nil
+		})
+		stopProgress()
+		if err != nil {
+			return err
+		}
+		if answers != nil {
+			answers.Put(key, result)
+		}
+		results = append(results, result)
+	}
+	if err = saveAnimation(); err != nil {
+		return err
+	}
+	if answers != nil {
+		if err = answers.Save(); err != nil {
+			return err
+		}
+	}
+
+	return writeResults(os.Stdout, *format, results)
+}
+
+// solveContext returns the context of the solutions, it is cancelled by Ctrl+C or when the timeout (if any) expires
+func solveContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	if timeout <= 0 {
+		return ctx, stop
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// startProgress shows the progress bar of the part on stderr (if the solver reports its progress at all),
+// the returned function removes it
+func startProgress(puzzle solver.Puzzle, s solver.Solver, part int) func() {
+	progressive, ok := s.(solver.Progressive)
+	if !ok {
+		return func() {}
+	}
+	bar := progress.New(os.Stderr, fmt.Sprintf("%s part %d", puzzle, part))
+	progressive.OnProgress(bar.Update)
+	return func() {
+		progressive.OnProgress(nil)
+		bar.Clear()
+	}
+}
+
+// parseInput creates a new solver of the puzzle and parses the input from the given path, stdin or the built-in example
+func parseInput(puzzle solver.Puzzle, path string, example string) (solver.Solver, error) {
+	input, inputName, err := readInput(puzzle, path, example)
+	if err != nil {
+		return nil, err
+	}
+	s := puzzle.New()
+	if err = parse(puzzle, s, input, inputName); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parse parses the input by the solver, the name of the input is added to the parse errors
+func parse(puzzle solver.Puzzle, s solver.Solver, input []byte, inputName string) error {
+	if err := s.Parse(bytes.NewReader(input)); err != nil {
+		return fmt.Errorf("%s: failed to parse input: %w", puzzle, utils.InFile(err, inputName))
+	}
+	return nil
+}
+
+// readInput reads the whole puzzle input from the given path, stdin or the built-in example
+func readInput(puzzle solver.Puzzle, path string, example string) ([]byte, string, error) {
+	input, inputName, err := openInput(puzzle, path, example)
+	if err != nil {
+		return nil, "", err
+	}
+	defer input.Close()
+	data, err := io.ReadAll(input)
+	return data, inputName, err
+}
+
+// openInput opens the puzzle input from the given path, stdin or the built-in example, the name is used in parse errors
+func openInput(puzzle solver.Puzzle, path string, example string) (io.ReadCloser, string, error) {
+	switch {
+	case path != "" && example != "":
+		return nil, "", fmt.Errorf("--input and --example cannot be used together")
+	case example != "":
+		input, err := puzzle.Example(example)
+		return input, "example:" + example, err
+	case path == "-":
+		return io.NopCloser(os.Stdin), "stdin", nil
+	case path == "":
+		path = filepath.Join(inputsDir, puzzle.InputFile())
+	}
+	input, err := os.Open(path)
+	return input, path, err
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/cache"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/runner"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// runAll solves all the puzzles of the year in parallel and prints the summary (or the results in the given format),
+// the cached answers are used unless useCache is false
+func runAll(ctx context.Context, year int, parts []int, workers int, timeout time.Duration, format string, useCache bool) error {
+	puzzles, err := getPuzzles(year, nil)
+	if err != nil {
+		return err
+	}
+	var answers *cache.Cache
+	if useCache {
+		if answers, err = cache.Load(cacheFile); err != nil {
+			return err
+		}
+	}
+	outcomes := runner.Run(ctx, puzzles, runner.Options{
+		Workers: workers,
+		Parts:   parts,
+		Timeout: timeout,
+		Open: func(puzzle solver.Puzzle) (io.ReadCloser, string, error) {
+			return openInput(puzzle, "", "")
+		},
+		Cache: answers,
+	})
+	if answers != nil {
+		if err = answers.Save(); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	results := make([]solver.Result, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome.Err != nil {
+			failed++
+			continue
+		}
+		results = append(results, outcome.Result)
+	}
+	if format == "text" {
+		err = writeSummary(os.Stdout, outcomes)
+	} else {
+		err = writeResults(os.Stdout, format, results)
+	}
+	if err != nil {
+		return err
+	}
+	for _, outcome := range outcomes {
+		var panicErr *runner.PanicError
+		if errors.As(outcome.Err, &panicErr) {
+			fmt.Fprintf(os.Stderr, "%s part %d %s\n%s\n", outcome.Puzzle, outcome.Part, panicErr, panicErr.Stack)
+		} else if outcome.Err != nil && format != "text" {
+			fmt.Fprintf(os.Stderr, "%s part %d: %s\n", outcome.Puzzle, outcome.Part, outcome.Err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d part(s) failed", failed)
+	}
+	return nil
+}
+
+// writeSummary prints a table with the answer, time and status of every part (with the error of the failed ones)
+func writeSummary(w io.Writer, outcomes []runner.Outcome) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "puzzle\tpart\tanswer\ttime\tstatus")
+	var total time.Duration
+	for _, outcome := range outcomes {
+		status := outcome.Status()
+		if outcome.Err != nil {
+			status += ": " + outcome.Err.Error()
+		}
+		fmt.Fprintf(table, "%s\t%d\t%s\t%s\t%s\n", outcome.Puzzle, outcome.Part, outcome.Result.Answer, outcome.Duration.Round(time.Microsecond), status)
+		total += outcome.Duration
+	}
+	fmt.Fprintf(table, "total\t\t\t%s\n", total.Round(time.Microsecond))
+	return table.Flush()
+}
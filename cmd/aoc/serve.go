@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/server"
+)
+
+func serveCommand(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8080", "address the server listens on")
+	maxInputSize := flags.Int64("max-input", server.DefaultMaxInputSize, "maximal size of the puzzle input in bytes")
+	timeout := flags.Duration("timeout", server.DefaultTimeout, "limit of solving a single request")
+	workers := flags.Int("workers", runtime.NumCPU(), "number of puzzles solved at once, more requests get 503")
+	maxUninterruptible := flags.Int("max-uninterruptible", 0, "how many workers may run solvers that cannot be stopped at the timeout (0 = half of them)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return fmt.Errorf("no arguments expected, got %d", len(positional))
+	}
+
+	srv := &http.Server{
+		Addr: *addr,
+		Handler: server.NewHandler(server.Options{
+			MaxInputSize:       *maxInputSize,
+			Timeout:            *timeout,
+			Workers:            *workers,
+			MaxUninterruptible: *maxUninterruptible,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx) // the requests still running after the timeout are dropped
+	}()
+
+	fmt.Fprintf(os.Stderr, "listening on http://%s, e.g. curl --data-binary @inputs/day_07.txt http://%s/2020/day/7/part/2\n", *addr, *addr)
+	if err = srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
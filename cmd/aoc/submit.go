@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/client"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+const historyFile = "inputs/submissions.json"
+
+func submitCommand(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzle")
+	inputPath := flags.String("input", "", "path to the puzzle input, - for stdin")
+	baseURL := flags.String("base-url", "", "base URL of the website (default from "+client.BaseURLEnv+" or "+client.DefaultBaseURL+")")
+	historyPath := flags.String("history", historyFile, "JSON file with the history of the submitted answers")
+	timeout := flags.Duration("timeout", 0, "stop the solution after the given time (0 = no limit)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("day and part expected, got %d arguments", len(positional))
+	}
+	day, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid day [%s]", positional[0])
+	}
+	part, err := strconv.Atoi(positional[1])
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("invalid part [%s], must be 1 or 2", positional[1])
+	}
+
+	puzzle, err := solver.Get(*year, day)
+	if err != nil {
+		return err
+	}
+	s, err := parseInput(puzzle, *inputPath, "")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := solveContext(*timeout)
+	defer cancel()
+	answer, err := solver.SolvePart(ctx, s, part)
+	if err != nil {
+		return fmt.Errorf("%s: part %d failed: %w", puzzle, part, err)
+	}
+	if answer == "" {
+		return fmt.Errorf("%s: part %d has no answer to submit", puzzle, part)
+	}
+
+	history, err := client.LoadHistory(*historyPath)
+	if err != nil {
+		return err
+	}
+	if err = history.Check(puzzle.String(), part, answer); err != nil {
+		return err
+	}
+
+	c := client.NewClient()
+	if *baseURL != "" {
+		c.BaseURL = strings.TrimSuffix(*baseURL, "/")
+	}
+	fmt.Printf("%s part %d: submitting [%s]\n", puzzle, part, answer)
+	verdict, err := c.Submit(puzzle.Year, puzzle.Day, part, answer)
+	if err != nil {
+		return err
+	}
+	history.Add(client.Attempt{
+		Puzzle:  puzzle.String(),
+		Part:    part,
+		Answer:  answer,
+		Outcome: verdict.Outcome,
+		Time:    time.Now().UTC(),
+	})
+	if err = history.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s part %d: %s\n", puzzle, part, verdict.Outcome)
+	if verdict.Wait > 0 {
+		fmt.Printf("wait %s before the next submission\n", verdict.Wait)
+	}
+	if verdict.Outcome == client.Unknown {
+		fmt.Println(verdict.Message)
+	}
+	if verdict.Outcome != client.Correct {
+		return fmt.Errorf("answer [%s] not accepted", answer)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/verify"
+)
+
+func verifyCommand(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	year := flags.Int("year", defaultYear, "edition of the puzzles")
+	timeout := flags.Duration("timeout", 0, "stop each puzzle after the given time (0 = no limit)")
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	puzzles, err := getPuzzles(*year, positional)
+	if err != nil {
+		return err
+	}
+	answers, err := verify.LoadAnswers(answersFile)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, puzzle := range puzzles {
+		input, err := os.Open(filepath.Join(inputsDir, puzzle.InputFile()))
+		if err != nil {
+			return err
+		}
+		ctx, cancel := solveContext(*timeout)
+		results := verify.Run(ctx, puzzle, input, answers)
+		cancel()
+		input.Close()
+		if len(results) == 0 {
+			fmt.Printf("%s: no known answers, skipped\n", puzzle)
+			continue
+		}
+		for _, result := range results {
+			if result.Passed() {
+				fmt.Printf("%s part %d: ok\n", puzzle, result.Part)
+				continue
+			}
+			failed++
+			fmt.Printf("%s part %d: FAIL\n%s", puzzle, result.Part, result.Diff())
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d part(s) failed", failed)
+	}
+	return nil
+}
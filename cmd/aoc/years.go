@@ -0,0 +1,6 @@
+package main
+
+// the editions register their days from init(), aoc new adds the new ones here
+import (
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020"
+)
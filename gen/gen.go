@@ -0,0 +1,75 @@
+// Package gen contains the helpers shared by the random input generators of the days
+package gen
+
+import (
+	"math/rand"
+	"strings"
+)
+
+const letters = "abcdefghijklmnopqrstuvwxyz"
+
+// Lines joins the lines of the input, including the trailing newline
+func Lines(lines []string) string {
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Word returns a random lowercase word of the given length
+func Word(r *rand.Rand, length int) string {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = letters[r.Intn(len(letters))]
+	}
+	return string(result)
+}
+
+// Words returns the given number of distinct random lowercase words with length between min and max
+func Words(r *rand.Rand, count int, min int, max int) []string {
+	result := make([]string, 0, count)
+	used := make(map[string]struct{}, count)
+	for len(result) < count {
+		word := Word(r, min+r.Intn(max-min+1))
+		if _, exists := used[word]; exists {
+			continue
+		}
+		used[word] = struct{}{}
+		result = append(result, word)
+	}
+	return result
+}
+
+// Pattern returns the lines of a random rectangle where each character is "on" with the given probability
+func Pattern(r *rand.Rand, width int, height int, density float64, on byte, off byte) []string {
+	result := make([]string, height)
+	for i := range result {
+		line := make([]byte, width)
+		for j := range line {
+			line[j] = off
+			if r.Float64() < density {
+				line[j] = on
+			}
+		}
+		result[i] = string(line)
+	}
+	return result
+}
+
+// Subset returns a random subset of the items, each one is selected with the given probability (in the original order)
+func Subset[T any](r *rand.Rand, items []T, probability float64) []T {
+	result := make([]T, 0)
+	for _, item := range items {
+		if r.Float64() < probability {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Shuffled returns a shuffled copy of the items
+func Shuffled[T any](r *rand.Rand, items []T) []T {
+	result := make([]T, len(items))
+	copy(result, items)
+	r.Shuffle(len(result), func(i, j int) {
+		result[i], result[j] = result[j], result[i]
+	})
+	return result
+}
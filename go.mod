@@ -0,0 +1,3 @@
+module github.com/tomas-hanicinec/AdventOfCode_2020
+
+go 1.22
@@ -0,0 +1,319 @@
+// Package grid implements a generic 2D grid of cells with neighborhoods, ray casting and transformations
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+// Point is a position in the grid, row 0 is the top one and column 0 the leftmost one
+type Point struct {
+	Row    int
+	Column int
+}
+
+func (p Point) Add(q Point) Point {
+	return Point{Row: p.Row + q.Row, Column: p.Column + q.Column}
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("[%d, %d]", p.Row, p.Column)
+}
+
+var (
+	Up        = Point{Row: -1}
+	Down      = Point{Row: 1}
+	Left      = Point{Column: -1}
+	Right     = Point{Column: 1}
+	UpLeft    = Point{Row: -1, Column: -1}
+	UpRight   = Point{Row: -1, Column: 1}
+	DownLeft  = Point{Row: 1, Column: -1}
+	DownRight = Point{Row: 1, Column: 1}
+)
+
+// Orthogonal is the 4-neighborhood (von Neumann)
+var Orthogonal = []Point{Up, Right, Down, Left}
+
+// Adjacent is the 8-neighborhood (Moore), including the diagonals
+var Adjacent = []Point{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+// Edge is the policy for the points outside the grid
+type Edge int
+
+const (
+	Bounded     Edge = iota // there is nothing outside the grid
+	WrapColumns             // the grid repeats infinitely to the left and right
+	Wrap                    // the grid repeats infinitely in all directions (torus)
+)
+
+type Grid[T any] struct {
+	Edge   Edge
+	width  int
+	height int
+	cells  []T // row by row
+}
+
+// New creates a bounded grid with all the cells set to the zero value
+func New[T any](width int, height int) *Grid[T] {
+	return &Grid[T]{
+		width:  width,
+		height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// Parse creates a bounded grid from the lines of text, each character is converted to a cell by the given function
+func Parse[T any](lines []string, parseCell func(c byte) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 || len(lines[0]) == 0 {
+		return nil, &utils.ParseError{Line: 1, Column: 1, Err: fmt.Errorf("empty grid")}
+	}
+	g := New[T](len(lines[0]), len(lines))
+	for i, line := range lines {
+		if len(line) != g.width {
+			return nil, &utils.ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("line width differs from the first line (%d)", g.width)}
+		}
+		for j := 0; j < len(line); j++ {
+			cell, err := parseCell(line[j])
+			if err != nil {
+				return nil, &utils.ParseError{Line: i + 1, Column: j + 1, Text: line[j : j+1], Err: err}
+			}
+			g.cells[i*g.width+j] = cell
+		}
+	}
+
+	return g, nil
+}
+
+// ParseBytes creates a grid of the characters, only the allowed ones can be used
+func ParseBytes(lines []string, allowed string) (*Grid[byte], error) {
+	return Parse(lines, func(c byte) (byte, error) {
+		if strings.IndexByte(allowed, c) < 0 {
+			return 0, fmt.Errorf("unsupported character, expected one of [%s]", allowed)
+		}
+		return c, nil
+	})
+}
+
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// Resolve maps the point to the grid according to the edge policy, false if the point is outside of the grid
+func (g *Grid[T]) Resolve(p Point) (Point, bool) {
+	if g.Edge == Wrap {
+		p.Row = mod(p.Row, g.height)
+	}
+	if g.Edge == Wrap || g.Edge == WrapColumns {
+		p.Column = mod(p.Column, g.width)
+	}
+
+	return p, p.Row >= 0 && p.Row < g.height && p.Column >= 0 && p.Column < g.width
+}
+
+func (g *Grid[T]) Contains(p Point) bool {
+	_, ok := g.Resolve(p)
+	return ok
+}
+
+// Get returns the cell at the given point, false if there is none
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	p, ok := g.Resolve(p)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Row*g.width+p.Column], true
+}
+
+// At returns the cell at the given point which must be inside the grid (no edge policy applied)
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Set changes the cell at the given point which must be inside the grid (no edge policy applied)
+func (g *Grid[T]) Set(p Point, value T) {
+	g.cells[g.index(p)] = value
+}
+
+func (g *Grid[T]) index(p Point) int {
+	if p.Row < 0 || p.Row >= g.height || p.Column < 0 || p.Column >= g.width {
+		panic(fmt.Errorf("point %s out of the %dx%d grid", p, g.width, g.height))
+	}
+	return p.Row*g.width + p.Column
+}
+
+// Each calls the function for all the cells, row by row
+func (g *Grid[T]) Each(f func(p Point, value T)) {
+	for i := 0; i < g.height; i++ {
+		for j := 0; j < g.width; j++ {
+			f(Point{Row: i, Column: j}, g.cells[i*g.width+j])
+		}
+	}
+}
+
+// Count returns the number of cells matching the condition
+func (g *Grid[T]) Count(match func(value T) bool) int {
+	result := 0
+	for _, cell := range g.cells {
+		if match(cell) {
+			result++
+		}
+	}
+	return result
+}
+
+// Neighbors calls the function for all the cells in the given directions from the point (e.g. Orthogonal or Adjacent)
+func (g *Grid[T]) Neighbors(p Point, directions []Point, f func(p Point, value T)) {
+	for _, direction := range directions {
+		neighbor, ok := g.Resolve(p.Add(direction))
+		if ok {
+			f(neighbor, g.cells[neighbor.Row*g.width+neighbor.Column])
+		}
+	}
+}
+
+// CountNeighbors returns the number of cells in the given directions from the point matching the condition
+func (g *Grid[T]) CountNeighbors(p Point, directions []Point, match func(value T) bool) int {
+	result := 0
+	g.Neighbors(p, directions, func(_ Point, value T) {
+		if match(value) {
+			result++
+		}
+	})
+	return result
+}
+
+// Cast follows the ray from the point (excluding the point itself) in the given direction and returns the first cell
+// matching the condition, false if the ray leaves the grid (or returns to the starting point of a wrapped grid) first
+func (g *Grid[T]) Cast(from Point, direction Point, match func(value T) bool) (Point, T, bool) {
+	start, _ := g.Resolve(from)
+	p := from
+	for {
+		p = p.Add(direction)
+		resolved, ok := g.Resolve(p)
+		if !ok || resolved == start {
+			var zero T
+			return Point{}, zero, false
+		}
+		if value := g.cells[resolved.Row*g.width+resolved.Column]; match(value) {
+			return resolved, value, true
+		}
+	}
+}
+
+// Row returns a copy of the i-th row
+func (g *Grid[T]) Row(i int) []T {
+	result := make([]T, g.width)
+	copy(result, g.cells[i*g.width:(i+1)*g.width])
+	return result
+}
+
+// Column returns a copy of the j-th column
+func (g *Grid[T]) Column(j int) []T {
+	result := make([]T, g.height)
+	for i := range result {
+		result[i] = g.cells[i*g.width+j]
+	}
+	return result
+}
+
+func (g *Grid[T]) Clone() *Grid[T] {
+	clone := *g
+	clone.cells = make([]T, len(g.cells))
+	copy(clone.cells, g.cells)
+	return &clone
+}
+
+// Sub returns a copy of the rectangular part of the grid starting at the given point (top left corner)
+func (g *Grid[T]) Sub(from Point, width int, height int) *Grid[T] {
+	result := New[T](width, height)
+	for i := 0; i < height; i++ {
+		start := g.index(Point{Row: from.Row + i, Column: from.Column})
+		g.index(Point{Row: from.Row + i, Column: from.Column + width - 1}) // bounds check of the last cell
+		copy(result.cells[i*width:(i+1)*width], g.cells[start:start+width])
+	}
+	return result
+}
+
+// Paste copies the other grid into this one, the top left corner of the other grid is placed at the given point
+func (g *Grid[T]) Paste(at Point, other *Grid[T]) {
+	for i := 0; i < other.height; i++ {
+		start := g.index(Point{Row: at.Row + i, Column: at.Column})
+		g.index(Point{Row: at.Row + i, Column: at.Column + other.width - 1}) // bounds check of the last cell
+		copy(g.cells[start:start+other.width], other.cells[i*other.width:(i+1)*other.width])
+	}
+}
+
+// transform creates a new grid of the given size with cell [i, j] taken from the source point returned by the function
+func (g *Grid[T]) transform(width int, height int, source func(i, j int) Point) *Grid[T] {
+	result := New[T](width, height)
+	result.Edge = g.Edge
+	for i := 0; i < height; i++ {
+		for j := 0; j < width; j++ {
+			p := source(i, j)
+			result.cells[i*width+j] = g.cells[p.Row*g.width+p.Column]
+		}
+	}
+	return result
+}
+
+// RotateClockwise returns a new grid rotated by the number of 90 degree steps clockwise (negative steps rotate counterclockwise)
+func (g *Grid[T]) RotateClockwise(steps int) *Grid[T] {
+	switch mod(steps, 4) {
+	case 1:
+		return g.transform(g.height, g.width, func(i, j int) Point { return Point{Row: g.height - 1 - j, Column: i} })
+	case 2:
+		return g.transform(g.width, g.height, func(i, j int) Point { return Point{Row: g.height - 1 - i, Column: g.width - 1 - j} })
+	case 3:
+		return g.transform(g.height, g.width, func(i, j int) Point { return Point{Row: j, Column: g.width - 1 - i} })
+	default:
+		return g.Clone()
+	}
+}
+
+// FlipHorizontal returns a new grid mirrored left to right
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.transform(g.width, g.height, func(i, j int) Point { return Point{Row: i, Column: g.width - 1 - j} })
+}
+
+// FlipVertical returns a new grid mirrored upside down
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.transform(g.width, g.height, func(i, j int) Point { return Point{Row: g.height - 1 - i, Column: j} })
+}
+
+// Orientations returns all 8 rotations and flips of the grid (the first one is the copy of the original)
+func (g *Grid[T]) Orientations() []*Grid[T] {
+	result := make([]*Grid[T], 0, 8)
+	flipped := g.FlipHorizontal()
+	for i := 0; i < 4; i++ {
+		result = append(result, g.RotateClockwise(i))
+	}
+	for i := 0; i < 4; i++ {
+		result = append(result, flipped.RotateClockwise(i))
+	}
+	return result
+}
+
+// Render prints the grid as text, one line per row, each cell is printed by the given function
+func (g *Grid[T]) Render(render func(value T) byte) string {
+	var sb strings.Builder
+	sb.Grow((g.width + 1) * g.height)
+	for i := 0; i < g.height; i++ {
+		for j := 0; j < g.width; j++ {
+			sb.WriteByte(render(g.cells[i*g.width+j]))
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// mod is the modulo which is never negative (unlike the % operator)
+func mod(a int, b int) int {
+	return (a%b + b) % b
+}
@@ -0,0 +1,157 @@
+package grid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+func render(g *Grid[byte]) string {
+	return g.Render(func(c byte) byte { return c })
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      []string
+		wantLine   int
+		wantColumn int
+	}{
+		{"valid", []string{"#.", ".#"}, 0, 0},
+		{"empty", []string{""}, 1, 1},
+		{"different width", []string{"#.", "#"}, 2, 1},
+		{"unsupported character", []string{"#.", ".x"}, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBytes(tt.lines, "#.")
+			if tt.wantLine == 0 {
+				if err != nil {
+					t.Errorf("ParseBytes() error: %s", err)
+				}
+				return
+			}
+			var parseErr *utils.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseBytes() error = %v, want a ParseError", err)
+			}
+			if parseErr.Line != tt.wantLine || parseErr.Column != tt.wantColumn {
+				t.Errorf("ParseBytes() error at %d:%d, want %d:%d", parseErr.Line, parseErr.Column, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+}
+
+func TestEdge(t *testing.T) {
+	g, _ := ParseBytes([]string{"abc", "def"}, "abcdef")
+	tests := []struct {
+		edge   Edge
+		p      Point
+		want   byte
+		wantOk bool
+	}{
+		{Bounded, Point{1, 2}, 'f', true},
+		{Bounded, Point{0, 3}, 0, false},
+		{WrapColumns, Point{0, 4}, 'b', true},
+		{WrapColumns, Point{0, -1}, 'c', true},
+		{WrapColumns, Point{2, 0}, 0, false},
+		{Wrap, Point{-1, -1}, 'f', true},
+		{Wrap, Point{5, 7}, 'e', true},
+	}
+	for _, tt := range tests {
+		g.Edge = tt.edge
+		if got, ok := g.Get(tt.p); got != tt.want || ok != tt.wantOk {
+			t.Errorf("Get(%s) with edge %d = %q, %t, want %q, %t", tt.p, tt.edge, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g, _ := ParseBytes([]string{
+		"#.#",
+		".##",
+		"#..",
+	}, "#.")
+	isTree := func(c byte) bool { return c == '#' }
+	tests := []struct {
+		p          Point
+		directions []Point
+		want       int
+	}{
+		{Point{1, 1}, Adjacent, 4},
+		{Point{1, 1}, Orthogonal, 1},
+		{Point{0, 0}, Adjacent, 1},
+		{Point{2, 2}, Orthogonal, 1},
+	}
+	for _, tt := range tests {
+		if got := g.CountNeighbors(tt.p, tt.directions, isTree); got != tt.want {
+			t.Errorf("CountNeighbors(%s) = %d, want %d", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestCast(t *testing.T) {
+	g, _ := ParseBytes([]string{
+		"L..#",
+		"....",
+		"#..L",
+	}, "#L.")
+	isSeat := func(c byte) bool { return c != '.' }
+	tests := []struct {
+		from      Point
+		direction Point
+		want      Point
+		wantOk    bool
+	}{
+		{Point{0, 0}, Right, Point{0, 3}, true},
+		{Point{0, 0}, Down, Point{2, 0}, true},
+		{Point{0, 0}, DownRight, Point{}, false},
+		{Point{2, 3}, Up, Point{0, 3}, true},
+		{Point{0, 0}, Left, Point{}, false},
+	}
+	for _, tt := range tests {
+		if got, _, ok := g.Cast(tt.from, tt.direction, isSeat); got != tt.want || ok != tt.wantOk {
+			t.Errorf("Cast(%s, %s) = %s, %t, want %s, %t", tt.from, tt.direction, got, ok, tt.want, tt.wantOk)
+		}
+	}
+
+	g.Edge = Wrap
+	if got, _, ok := g.Cast(Point{0, 0}, Left, isSeat); !ok || got != (Point{0, 3}) {
+		t.Errorf("Cast() on a wrapped grid = %s, %t, want [0, 3], true", got, ok)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g, _ := ParseBytes([]string{
+		"abc",
+		"def",
+	}, "abcdef")
+	tests := []struct {
+		name string
+		got  *Grid[byte]
+		want string
+	}{
+		{"rotate 1", g.RotateClockwise(1), "da\neb\nfc\n"},
+		{"rotate 2", g.RotateClockwise(2), "fed\ncba\n"},
+		{"rotate 3", g.RotateClockwise(3), "cf\nbe\nad\n"},
+		{"rotate -1", g.RotateClockwise(-1), "cf\nbe\nad\n"},
+		{"rotate 4", g.RotateClockwise(4), "abc\ndef\n"},
+		{"flip horizontal", g.FlipHorizontal(), "cba\nfed\n"},
+		{"flip vertical", g.FlipVertical(), "def\nabc\n"},
+		{"sub", g.Sub(Point{0, 1}, 2, 2), "bc\nef\n"},
+	}
+	for _, tt := range tests {
+		if got := render(tt.got); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	unique := make(map[string]struct{})
+	for _, orientation := range g.Orientations() {
+		unique[render(orientation)] = struct{}{}
+	}
+	if len(unique) != 8 {
+		t.Errorf("Orientations() returned %d unique grids, want 8", len(unique))
+	}
+}
@@ -0,0 +1,14 @@
+package grid
+
+import (
+	"embed"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/source"
+)
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	source.Register("github.com/tomas-hanicinec/AdventOfCode_2020/grid", sources)
+}
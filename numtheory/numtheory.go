@@ -0,0 +1,148 @@
+// Package numtheory implements the modular arithmetic needed by the puzzles (all the moduli must be positive)
+package numtheory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// ErrNoSolution is returned when the congruences or the discrete logarithm have no solution
+var ErrNoSolution = errors.New("no solution")
+
+// Mod is the modulo which is never negative (unlike the % operator)
+func Mod(a int64, m int64) int64 {
+	return (a%m + m) % m
+}
+
+// MulMod multiplies the numbers modulo m without overflowing even if the product does not fit into int64
+func MulMod(a int64, b int64, m int64) int64 {
+	hi, lo := bits.Mul64(uint64(Mod(a, m)), uint64(Mod(b, m)))
+	return int64(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// ModPow computes base^exp modulo m by repeated squaring, exp must not be negative
+func ModPow(base int64, exp int64, m int64) int64 {
+	result := Mod(1, m)
+	base = Mod(base, m)
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+		exp >>= 1
+	}
+	return result
+}
+
+// GCD is the greatest common divisor via Euclidean algorithm
+func GCD(a int64, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM is the least common multiple of all the numbers
+func LCM(a int64, b int64, integers ...int64) int64 {
+	result := a / GCD(a, b) * b
+	for _, integer := range integers {
+		result = LCM(result, integer)
+	}
+	return result
+}
+
+// ExtendedGCD returns the greatest common divisor g and the coefficients of the Bezout's identity a*x + b*y = g
+func ExtendedGCD(a int64, b int64) (g int64, x int64, y int64) {
+	oldR, r := a, b
+	oldX, x := int64(1), int64(0)
+	oldY, y := int64(0), int64(1)
+	for r != 0 {
+		quotient := oldR / r
+		oldR, r = r, oldR-quotient*r
+		oldX, x = x, oldX-quotient*x
+		oldY, y = y, oldY-quotient*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns x such that a*x = 1 modulo m, it exists only if a and m are coprime
+func ModInverse(a int64, m int64) (int64, error) {
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%d has no inverse modulo %d (common divisor %d): %w", a, m, g, ErrNoSolution)
+	}
+	return Mod(x, m), nil
+}
+
+// CRT solves the system of congruences x = residues[i] modulo moduli[i] (Chinese Remainder Theorem),
+// the moduli do not need to be coprime, returns the smallest non-negative solution and the modulus of all the solutions
+func CRT(residues []int64, moduli []int64) (int64, int64, error) {
+	if len(residues) != len(moduli) {
+		return 0, 0, fmt.Errorf("%d residues and %d moduli given", len(residues), len(moduli))
+	}
+	x, m := int64(0), int64(1)
+	for i := range moduli {
+		if moduli[i] < 1 {
+			return 0, 0, fmt.Errorf("modulus [%d] must be positive", moduli[i])
+		}
+		// x + m*k = residue (mod modulus) -> m*k = residue - x (mod modulus)
+		residue := Mod(residues[i], moduli[i])
+		g, p, _ := ExtendedGCD(m, moduli[i])
+		diff := residue - Mod(x, moduli[i])
+		if diff%g != 0 {
+			return 0, 0, fmt.Errorf("x = %d (mod %d) contradicts the previous congruences: %w", residues[i], moduli[i], ErrNoSolution)
+		}
+		step := moduli[i] / g
+		hi, newM := bits.Mul64(uint64(m), uint64(step))
+		if hi != 0 || newM > math.MaxInt64 {
+			return 0, 0, fmt.Errorf("modulus of the solution overflows int64")
+		}
+		k := MulMod(diff/g, p, step)
+		x = Mod(x+MulMod(m, k, int64(newM)), int64(newM))
+		m = int64(newM)
+	}
+	return x, m, nil
+}
+
+// DiscreteLog returns the smallest non-negative x such that base^x = target modulo m (baby-step giant-step),
+// the base and the modulus must be coprime
+func DiscreteLog(base int64, target int64, m int64) (int64, error) {
+	base, target = Mod(base, m), Mod(target, m)
+	if GCD(base, m) != 1 {
+		return 0, fmt.Errorf("base %d and modulus %d must be coprime", base, m)
+	}
+	n := int64(math.Ceil(math.Sqrt(float64(m))))
+
+	// baby steps: base^j for all j < n, only the smallest j is kept for repeated values
+	babySteps := make(map[int64]int64, n)
+	current := Mod(1, m)
+	for j := int64(0); j < n; j++ {
+		if _, exists := babySteps[current]; !exists {
+			babySteps[current] = j
+		}
+		current = MulMod(current, base, m)
+	}
+
+	// giant steps: target * base^(-n*i) for all i <= n
+	inverse, err := ModInverse(ModPow(base, n, m), m)
+	if err != nil {
+		return 0, err
+	}
+	gamma := target
+	for i := int64(0); i <= n; i++ {
+		if j, ok := babySteps[gamma]; ok {
+			return i*n + j, nil
+		}
+		gamma = MulMod(gamma, inverse, m)
+	}
+
+	return 0, fmt.Errorf("%d is not a power of %d modulo %d: %w", target, base, m, ErrNoSolution)
+}
@@ -0,0 +1,122 @@
+package numtheory
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		base, exp, m int64
+		want         int64
+	}{
+		{7, 8, 20201227, 5764801},
+		{17807724, 8, 20201227, 14897079},
+		{2, 0, 5, 1},
+		{-2, 3, 5, 2},
+	}
+	for _, tt := range tests {
+		if got := ModPow(tt.base, tt.exp, tt.m); got != tt.want {
+			t.Errorf("ModPow(%d, %d, %d) = %d, want %d", tt.base, tt.exp, tt.m, got, tt.want)
+		}
+	}
+
+	// Fermat's little theorem: a^(p-1) = 1 (mod p) for a large prime p
+	const p = 1000000000000037
+	if got := ModPow(123456789123, p-1, p); got != 1 {
+		t.Errorf("ModPow(a, p-1, p) = %d, want 1", got)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	tests := []struct {
+		a, b  int64
+		wantG int64
+	}{
+		{240, 46, 2},
+		{17, 5, 1},
+		{0, 7, 7},
+		{-12, 18, 6},
+	}
+	for _, tt := range tests {
+		g, x, y := ExtendedGCD(tt.a, tt.b)
+		if g != tt.wantG || tt.a*x+tt.b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = (%d, %d, %d), want gcd %d satisfying a*x + b*y = gcd", tt.a, tt.b, g, x, y, tt.wantG)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if got, err := ModInverse(3, 11); err != nil || got != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", got, err)
+	}
+	if got, err := ModInverse(-3, 11); err != nil || got != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", got, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("ModInverse(6, 9) error = %v, want ErrNoSolution", err)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name     string
+		residues []int64
+		moduli   []int64
+		want     int64
+		wantM    int64
+		wantErr  bool
+	}{
+		{"coprime", []int64{2, 3, 2}, []int64{3, 5, 7}, 23, 105, false},
+		{"not coprime", []int64{2, 8}, []int64{6, 10}, 8, 30, false},
+		{"contradiction", []int64{1, 2}, []int64{4, 6}, 0, 0, true},
+		{"negative residues", []int64{0, -1, -4, -6, -7}, []int64{7, 13, 59, 31, 19}, 1068781, 3162341, false},
+		{"large moduli", []int64{0, -1}, []int64{1000000007, 998244353}, 0, 0, false}, // only the congruences are checked
+		{"empty", nil, nil, 0, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotM, err := CRT(tt.residues, tt.moduli)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNoSolution) {
+					t.Errorf("CRT() error = %v, want ErrNoSolution", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CRT() error: %s", err)
+			}
+			for i := range tt.moduli {
+				if Mod(got, tt.moduli[i]) != Mod(tt.residues[i], tt.moduli[i]) {
+					t.Errorf("CRT() = %d, it is not %d modulo %d", got, tt.residues[i], tt.moduli[i])
+				}
+			}
+			if tt.wantM != 0 && (got != tt.want || gotM != tt.wantM) {
+				t.Errorf("CRT() = (%d, %d), want (%d, %d)", got, gotM, tt.want, tt.wantM)
+			}
+		})
+	}
+}
+
+func TestDiscreteLog(t *testing.T) {
+	tests := []struct {
+		base, target, m int64
+		want            int64
+	}{
+		{7, 5764801, 20201227, 8},
+		{7, 17807724, 20201227, 11},
+		{3, 13, 17, 4},
+		{2, 1, 11, 0},
+	}
+	for _, tt := range tests {
+		got, err := DiscreteLog(tt.base, tt.target, tt.m)
+		if err != nil || got != tt.want {
+			t.Errorf("DiscreteLog(%d, %d, %d) = %d, %v, want %d", tt.base, tt.target, tt.m, got, err, tt.want)
+		}
+	}
+
+	// 4 generates only the quadratic residues modulo 7 (1, 2 and 4)
+	if _, err := DiscreteLog(4, 3, 7); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("DiscreteLog(4, 3, 7) error = %v, want ErrNoSolution", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Package progress draws the progress of the long-running solutions as a bar in the terminal
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultWidth = 30
+const defaultInterval = 100 * time.Millisecond
+
+// Bar is a single line progress bar redrawn in place, it is meant to be used as a solver.ProgressFunc
+type Bar struct {
+	out      io.Writer
+	label    string
+	width    int           // number of characters of the bar itself
+	interval time.Duration // the bar is not redrawn more often (except for the last step)
+	lastDraw time.Time
+	drawn    bool
+}
+
+func New(out io.Writer, label string) *Bar {
+	return &Bar{
+		out:      out,
+		label:    label,
+		width:    defaultWidth,
+		interval: defaultInterval,
+	}
+}
+
+// Update redraws the bar with the current step, an unknown total (0) shows just the step
+func (b *Bar) Update(step int, total int) {
+	now := time.Now()
+	if b.drawn && now.Sub(b.lastDraw) < b.interval && (total <= 0 || step < total) {
+		return // too soon, the terminal would only flicker
+	}
+	b.lastDraw = now
+	b.drawn = true
+	fmt.Fprintf(b.out, "\r%s", b.render(step, total))
+}
+
+// Clear removes the bar from the line (if it was drawn at all)
+func (b *Bar) Clear() {
+	if b.drawn {
+		fmt.Fprint(b.out, "\r\033[K")
+		b.drawn = false
+	}
+}
+
+func (b *Bar) render(step int, total int) string {
+	if total <= 0 {
+		return fmt.Sprintf("%s: step %d", b.label, step)
+	}
+	step = max(0, min(step, total))
+	filled := b.width * step / total
+	return fmt.Sprintf("%s [%s%s] %3d%% %d/%d", b.label, strings.Repeat("=", filled), strings.Repeat(" ", b.width-filled), 100*step/total, step, total)
+}
+
+// IsTerminal is true if the file is a terminal (and not e.g. a pipe or a regular file)
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
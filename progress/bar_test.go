@@ -0,0 +1,43 @@
+package progress
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	bar := New(nil, "2020/15 part 2")
+	bar.width = 10
+	tests := []struct {
+		step  int
+		total int
+		want  string
+	}{
+		{0, 100, "2020/15 part 2 [          ]   0% 0/100"},
+		{45, 100, "2020/15 part 2 [====      ]  45% 45/100"},
+		{100, 100, "2020/15 part 2 [==========] 100% 100/100"},
+		{120, 100, "2020/15 part 2 [==========] 100% 100/100"},
+		{1234, 0, "2020/15 part 2: step 1234"},
+	}
+	for _, tt := range tests {
+		if got := bar.render(tt.step, tt.total); got != tt.want {
+			t.Errorf("render(%d, %d) = %q, want %q", tt.step, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var out bytes.Buffer
+	bar := New(&out, "day")
+	bar.width = 4
+	bar.Update(1, 4)
+	bar.Update(2, 4) // too soon after the first one
+	bar.Update(4, 4) // the last step is always drawn
+	bar.Clear()
+	bar.Clear() // nothing to clear any more
+
+	want := "\rday [=   ]  25% 1/4\rday [====] 100% 4/4\r\033[K"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
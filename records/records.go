@@ -0,0 +1,94 @@
+// Package records splits the puzzle input into blocks of lines separated by blank lines and into named sections
+package records
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+// Block is a group of consecutive non-blank lines
+type Block struct {
+	Line  int // line number of the first line of the block in the input (starting from 1)
+	Lines []string
+}
+
+// Locate moves the parse error found in the lines of the block to the position in the whole input,
+// any other error (or a parse error without the line) is reported at the first line of the block
+func (b Block) Locate(err error) error {
+	if err == nil {
+		return nil
+	}
+	var parseError *utils.ParseError
+	if errors.As(err, &parseError) && parseError.Line > 0 {
+		return utils.OffsetLine(err, b.Line-1)
+	}
+	return utils.AtLine(err, b.Line, "")
+}
+
+// Split splits the lines to blocks separated by one or more blank lines, the last block does not need to be terminated
+// by a blank line and the trailing "\r" of CRLF line endings is ignored
+func Split(lines []string) []Block {
+	blocks := make([]Block, 0)
+	start := -1
+	for i := 0; i <= len(lines); i++ {
+		blank := i == len(lines) || strings.TrimSpace(lines[i]) == "" // the end of input terminates the last block
+		if !blank && start < 0 {
+			start = i
+		}
+		if blank && start >= 0 {
+			block := Block{Line: start + 1, Lines: make([]string, i-start)}
+			for j := range block.Lines {
+				block.Lines[j] = strings.TrimSuffix(lines[start+j], "\r")
+			}
+			blocks = append(blocks, block)
+			start = -1
+		}
+	}
+
+	return blocks
+}
+
+// SplitN splits the lines to blocks like Split, but there must be exactly the given number of them
+func SplitN(lines []string, count int) ([]Block, error) {
+	blocks := Split(lines)
+	if len(blocks) < count {
+		return nil, fmt.Errorf("%d blocks separated by a blank line expected, found %d", count, len(blocks))
+	}
+	if len(blocks) > count {
+		return nil, &utils.ParseError{Line: blocks[count].Line, Column: 1, Text: blocks[count].Lines[0], Err: fmt.Errorf("unexpected block, only %d blocks separated by a blank line expected", count)}
+	}
+
+	return blocks, nil
+}
+
+// Section is a block starting with a header line (e.g. "Player 1:"), the lines of the block do not include the header
+type Section struct {
+	Header string
+	Block
+}
+
+// Sections converts the blocks to sections, there must be exactly one section for each header in the given order
+func Sections(blocks []Block, headers ...string) ([]Section, error) {
+	if len(blocks) < len(headers) {
+		return nil, fmt.Errorf("section [%s] missing", headers[len(blocks)])
+	}
+	if len(blocks) > len(headers) {
+		return nil, &utils.ParseError{Line: blocks[len(headers)].Line, Column: 1, Text: blocks[len(headers)].Lines[0], Err: fmt.Errorf("unexpected section")}
+	}
+
+	sections := make([]Section, len(blocks))
+	for i, block := range blocks {
+		if block.Lines[0] != headers[i] {
+			return nil, &utils.ParseError{Line: block.Line, Column: 1, Text: block.Lines[0], Err: fmt.Errorf("invalid section header, expected [%s]", headers[i])}
+		}
+		sections[i] = Section{
+			Header: headers[i],
+			Block:  Block{Line: block.Line + 1, Lines: block.Lines[1:]},
+		}
+	}
+
+	return sections, nil
+}
@@ -0,0 +1,86 @@
+package records
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Block
+	}{
+		{"trailing newline", "a\nb\n\nc\n", []Block{{1, []string{"a", "b"}}, {4, []string{"c"}}}},
+		{"no trailing newline", "a\nb\n\nc", []Block{{1, []string{"a", "b"}}, {4, []string{"c"}}}},
+		{"CRLF", "a\r\nb\r\n\r\nc\r\n", []Block{{1, []string{"a", "b"}}, {4, []string{"c"}}}},
+		{"more blank lines", "\na\n\n \n\nc\n\n", []Block{{2, []string{"a"}}, {6, []string{"c"}}}},
+		{"empty", "", []Block{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Split(strings.Split(tt.input, "\n")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantErr  string
+		wantLine int
+	}{
+		{"valid", "Player 1:\n1\n2\n\nPlayer 2:\n3", "", 0},
+		{"empty section", "Player 1:\n\nPlayer 2:\n3", "", 0},
+		{"missing section", "Player 1:\n1", "section [Player 2:] missing", 0},
+		{"invalid header", "Player 1:\n1\n\nPlayer 3:\n3", "invalid section header, expected [Player 2:]", 4},
+		{"unexpected section", "Player 1:\n1\n\nPlayer 2:\n3\n\nPlayer 3:\n4", "unexpected section", 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections, err := Sections(Split(strings.Split(tt.input, "\n")), "Player 1:", "Player 2:")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Sections() error: %s", err)
+				}
+				if sections[1].Header != "Player 2:" || sections[1].Lines[0] != "3" {
+					t.Errorf("Sections() = %v, want the second section with card 3", sections)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Sections() error = %v, want [%s]", err, tt.wantErr)
+			}
+			var parseErr *utils.ParseError
+			if tt.wantLine > 0 && (!errors.As(err, &parseErr) || parseErr.Line != tt.wantLine) {
+				t.Errorf("Sections() error = %v, want it at line %d", err, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	block := Block{Line: 5, Lines: []string{"a", "b"}}
+	tests := []struct {
+		name     string
+		err      error
+		wantLine int
+	}{
+		{"parse error in the block", &utils.ParseError{Line: 2, Column: 1, Err: errors.New("invalid")}, 6},
+		{"parse error without the line", utils.NewParseError(3, "x", errors.New("invalid")), 5},
+		{"other error", errors.New("invalid"), 5},
+	}
+	for _, tt := range tests {
+		var parseErr *utils.ParseError
+		if err := block.Locate(tt.err); !errors.As(err, &parseErr) || parseErr.Line != tt.wantLine {
+			t.Errorf("%s: Locate() = %v, want it at line %d", tt.name, err, tt.wantLine)
+		}
+	}
+}
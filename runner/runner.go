@@ -0,0 +1,188 @@
+// Package runner solves many puzzles concurrently, a failing (or panicking) puzzle does not stop the others
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/cache"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// Options of running the puzzles
+type Options struct {
+	Workers int           // how many puzzles are solved at the same time
+	Parts   []int         // parts solved for every puzzle
+	Timeout time.Duration // limit of solving all the parts of a single puzzle (0 = no limit)
+	// Open returns the input of the puzzle and its name for the parse errors
+	Open func(puzzle solver.Puzzle) (io.ReadCloser, string, error)
+	// Cache of the answers, the cached parts are not solved again and the new answers are added (nil = no cache)
+	Cache *cache.Cache
+}
+
+// Outcome of a single part of a puzzle
+type Outcome struct {
+	Puzzle   solver.Puzzle
+	Part     int
+	Result   solver.Result // the answer (only if there is no error)
+	Duration time.Duration // how long the part ran, even if it failed
+	Err      error
+}
+
+const (
+	StatusOK      = "ok"
+	StatusCached  = "cached"
+	StatusError   = "error"
+	StatusTimeout = "timeout"
+	StatusPanic   = "panic"
+)
+
+// Status is a short description of the outcome for the summary
+func (o Outcome) Status() string {
+	var panicErr *PanicError
+	switch {
+	case o.Err == nil && o.Result.Cached:
+		return StatusCached
+	case o.Err == nil:
+		return StatusOK
+	case errors.As(o.Err, &panicErr):
+		return StatusPanic
+	case errors.Is(o.Err, context.DeadlineExceeded):
+		return StatusTimeout
+	default:
+		return StatusError
+	}
+}
+
+// PanicError is the failure of a puzzle which panicked, the rest of the puzzles is not affected
+type PanicError struct {
+	Value any    // the value passed to panic()
+	Stack []byte // stack trace of the panicking goroutine
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Run solves the parts of all the puzzles with a bounded number of workers, the outcomes are in the order
+// of the puzzles and the parts (each puzzle is parsed once and its parts are solved one by one)
+func Run(ctx context.Context, puzzles []solver.Puzzle, options Options) []Outcome {
+	outcomes := make([]Outcome, len(puzzles)*len(options.Parts))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(1, options.Workers); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				first := i * len(options.Parts)
+				copy(outcomes[first:first+len(options.Parts)], runPuzzle(ctx, puzzles[i], options))
+			}
+		}()
+	}
+	for i := range puzzles {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return outcomes
+}
+
+// runPuzzle parses the input and solves the parts which are not cached, a parse error or a panic fails all
+// the remaining parts
+func runPuzzle(ctx context.Context, puzzle solver.Puzzle, options Options) []Outcome {
+	outcomes := make([]Outcome, len(options.Parts))
+	for i, part := range options.Parts {
+		outcomes[i] = Outcome{Puzzle: puzzle, Part: part}
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	input, name, err := read(puzzle, options.Open)
+	if err != nil {
+		for i := range outcomes {
+			outcomes[i].Err = err
+		}
+		return outcomes
+	}
+	answers := options.Cache
+	version, versioned := puzzle.Version()
+	if !versioned {
+		answers = nil // a change of the solver could not be told from the cached answers
+	}
+	s := puzzle.New()
+	parsed := false
+	for i := range outcomes {
+		key := cache.NewKey(puzzle, outcomes[i].Part, input, version)
+		if answers != nil {
+			if result, ok := answers.Get(key); ok {
+				outcomes[i].Result = result
+				continue
+			}
+		}
+		if !parsed {
+			if err = parse(s, input, name); err != nil {
+				for j := i; j < len(outcomes); j++ {
+					outcomes[j].Err = err
+				}
+				break
+			}
+			parsed = true
+		}
+
+		start := time.Now()
+		outcomes[i].Result, outcomes[i].Err = solve(ctx, puzzle, s, outcomes[i].Part)
+		outcomes[i].Duration = time.Since(start)
+		if outcomes[i].Err == nil && answers != nil {
+			answers.Put(key, outcomes[i].Result)
+		}
+		var panicErr *PanicError
+		if errors.As(outcomes[i].Err, &panicErr) {
+			for j := i + 1; j < len(outcomes); j++ {
+				outcomes[j].Err = fmt.Errorf("not solved after the panic of part %d", outcomes[i].Part)
+			}
+			break // the state of the solver is unknown
+		}
+	}
+	return outcomes
+}
+
+func read(puzzle solver.Puzzle, open func(puzzle solver.Puzzle) (io.ReadCloser, string, error)) ([]byte, string, error) {
+	input, name, err := open(puzzle)
+	if err != nil {
+		return nil, "", err
+	}
+	defer input.Close()
+	data, err := io.ReadAll(input)
+	return data, name, err
+}
+
+func parse(s solver.Solver, input []byte, name string) (err error) {
+	defer recoverPanic(&err)
+	if err = s.Parse(bytes.NewReader(input)); err != nil {
+		return fmt.Errorf("failed to parse input [%s]: %w", name, err)
+	}
+	return nil
+}
+
+func solve(ctx context.Context, puzzle solver.Puzzle, s solver.Solver, part int) (result solver.Result, err error) {
+	defer recoverPanic(&err)
+	return solver.Solve(ctx, puzzle, s, part)
+}
+
+// recoverPanic turns a panic of the deferring function into a PanicError
+func recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
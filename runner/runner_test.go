@@ -0,0 +1,129 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/cache"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// fakeSolver answers with its input, "panic" panics in part one, "slow" runs until the context is cancelled
+type fakeSolver struct {
+	solver.ContextHolder
+	input string
+}
+
+func (s *fakeSolver) Parse(input io.Reader) error {
+	data, err := io.ReadAll(input)
+	if strings.HasPrefix(string(data), "invalid") {
+		return fmt.Errorf("invalid input")
+	}
+	s.input = string(data)
+	return err
+}
+
+func (s *fakeSolver) PartOne() (string, error) {
+	switch s.input {
+	case "panic":
+		panic("part one exploded")
+	case "slow":
+		<-s.Context().Done()
+		return "", solver.Interrupted(s.Context(), "the end")
+	}
+	return s.input + "1", nil
+}
+
+func (s *fakeSolver) PartTwo() (string, error) {
+	return s.input + "2", nil
+}
+
+func TestRun(t *testing.T) {
+	inputs := []string{"a", "panic", "b", "invalid", "slow", "c"}
+	puzzles := make([]solver.Puzzle, len(inputs))
+	for i := range inputs {
+		puzzles[i] = solver.Puzzle{Year: 2020, Day: i + 1, New: func() solver.Solver { return &fakeSolver{} }}
+	}
+	var running, maxRunning atomic.Int32
+	open := func(puzzle solver.Puzzle) (io.ReadCloser, string, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			previous := maxRunning.Load()
+			if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return io.NopCloser(strings.NewReader(inputs[puzzle.Day-1])), puzzle.String(), nil
+	}
+
+	outcomes := Run(context.Background(), puzzles, Options{Workers: 2, Parts: []int{1, 2}, Timeout: 50 * time.Millisecond, Open: open})
+
+	want := []struct {
+		answer string
+		status string
+	}{
+		{"a1", StatusOK}, {"a2", StatusOK},
+		{"", StatusPanic}, {"", StatusError},
+		{"b1", StatusOK}, {"b2", StatusOK},
+		{"", StatusError}, {"", StatusError},
+		{"", StatusTimeout}, {"", StatusTimeout},
+		{"c1", StatusOK}, {"c2", StatusOK},
+	}
+	if len(outcomes) != len(want) {
+		t.Fatalf("got %d outcomes, want %d", len(outcomes), len(want))
+	}
+	for i, outcome := range outcomes {
+		if outcome.Puzzle.Day != i/2+1 || outcome.Part != i%2+1 {
+			t.Errorf("outcome %d is day %d part %d, want day %d part %d", i, outcome.Puzzle.Day, outcome.Part, i/2+1, i%2+1)
+		}
+		if outcome.Result.Answer != want[i].answer || outcome.Status() != want[i].status {
+			t.Errorf("day %d part %d = %q (%s, %v), want %q (%s)", outcome.Puzzle.Day, outcome.Part, outcome.Result.Answer, outcome.Status(), outcome.Err, want[i].answer, want[i].status)
+		}
+	}
+	var panicErr *PanicError
+	if !errors.As(outcomes[2].Err, &panicErr) || panicErr.Value != "part one exploded" || len(panicErr.Stack) == 0 {
+		t.Errorf("panic error = %#v, want the panic value with the stack", outcomes[2].Err)
+	}
+	if maxRunning.Load() > 2 {
+		t.Errorf("%d puzzles ran at the same time, want at most 2", maxRunning.Load())
+	}
+}
+
+func TestRunCached(t *testing.T) {
+	answers, err := cache.Load(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	puzzle := solver.Puzzle{
+		Year:    2020,
+		Day:     1,
+		New:     func() solver.Solver { return &fakeSolver{} },
+		Sources: fstest.MapFS{"day01.go": {Data: []byte("package day01")}},
+	}
+	version, _ := puzzle.Version()
+	answers.Put(cache.NewKey(puzzle, 1, []byte("a"), version), solver.Result{Year: 2020, Day: 1, Part: 1, Answer: "cached"})
+	open := func(puzzle solver.Puzzle) (io.ReadCloser, string, error) {
+		return io.NopCloser(strings.NewReader("a")), puzzle.String(), nil
+	}
+
+	outcomes := Run(context.Background(), []solver.Puzzle{puzzle}, Options{Workers: 1, Parts: []int{1, 2}, Open: open, Cache: answers})
+	if outcomes[0].Result.Answer != "cached" || outcomes[0].Status() != StatusCached {
+		t.Errorf("part 1 = %q (%s), want the cached answer", outcomes[0].Result.Answer, outcomes[0].Status())
+	}
+	if outcomes[1].Result.Answer != "a2" || outcomes[1].Status() != StatusOK {
+		t.Errorf("part 2 = %q (%s), want the solved answer a2", outcomes[1].Result.Answer, outcomes[1].Status())
+	}
+	if result, ok := answers.Get(cache.NewKey(puzzle, 2, []byte("a"), version)); !ok || result.Answer != "a2" {
+		t.Errorf("solved part 2 not cached")
+	}
+}
@@ -0,0 +1,212 @@
+// Package scaffold creates the skeleton of a new day (and of a new edition if needed) registered in the solver registry
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// RegistryFile imports all the editions so that their days get registered (relative to the repository root)
+const RegistryFile = "cmd/aoc/years.go"
+
+const inputsDir = "inputs"
+
+// Day is the data of the templates
+type Day struct {
+	Module string // path of the Go module of the repository
+	Year   int
+	Day    int
+}
+
+// Package is the name of the package of the day, e.g. day07
+func (d Day) Package() string {
+	return fmt.Sprintf("day%02d", d.Day)
+}
+
+// YearPackage is the name of the package of the edition, e.g. y2020
+func (d Day) YearPackage() string {
+	return fmt.Sprintf("y%d", d.Year)
+}
+
+// Create writes the skeleton of the day to the repository with the given root and registers it, it returns the paths
+// of all the created and changed files
+func Create(root string, year int, day int) ([]string, error) {
+	if year < 2015 || year > 2100 {
+		return nil, fmt.Errorf("invalid year [%d], Advent of Code started in 2015", year)
+	}
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day [%d], must be between 1 and 25", day)
+	}
+	module, err := readModule(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	d := Day{Module: module, Year: year, Day: day}
+
+	yearDir := filepath.Join(root, d.YearPackage())
+	dayDir := filepath.Join(yearDir, d.Package())
+	if _, err = os.Stat(dayDir); err == nil {
+		return nil, fmt.Errorf("package of [%d/%02d] already exists in [%s]", year, day, dayDir)
+	}
+	changed := make([]string, 0, 6)
+	files := []struct {
+		template string
+		path     string
+	}{
+		{"day.go.tmpl", filepath.Join(dayDir, d.Package()+".go")},
+		{"day_test.go.tmpl", filepath.Join(dayDir, d.Package()+"_test.go")},
+	}
+	for _, file := range files {
+		if err = writeTemplate(file.template, file.path, d); err != nil {
+			return changed, err
+		}
+		changed = append(changed, file.path)
+	}
+
+	// the example is embedded, there must be at least an empty one; the empty input is downloaded by fetch
+	empty := []string{
+		filepath.Join(dayDir, "examples", "example.txt"),
+		filepath.Join(root, inputsDir, solver.Puzzle{Year: year, Day: day}.InputFile()),
+	}
+	for _, path := range empty {
+		created, err := createEmpty(path)
+		if err != nil {
+			return changed, err
+		}
+		if created {
+			changed = append(changed, path)
+		}
+	}
+
+	// register the day in its edition and the edition in the aoc command
+	yearFile := filepath.Join(yearDir, d.YearPackage()+".go")
+	registrations := []struct {
+		path       string
+		importPath string
+	}{
+		{yearFile, module + "/" + d.YearPackage() + "/" + d.Package()},
+		{filepath.Join(root, RegistryFile), module + "/" + d.YearPackage()},
+	}
+	if _, err = os.Stat(yearFile); errors.Is(err, os.ErrNotExist) {
+		if err = writeTemplate("year.go.tmpl", yearFile, d); err != nil {
+			return changed, err
+		}
+		changed = append(changed, yearFile)
+		registrations = registrations[1:]
+	}
+	for _, registration := range registrations {
+		added, err := addImport(registration.path, registration.importPath)
+		if err != nil {
+			return changed, err
+		}
+		if added {
+			changed = append(changed, registration.path)
+		}
+	}
+
+	return changed, nil
+}
+
+// readModule returns the module path from the go.mod file
+func readModule(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the module, run it from the repository root: %w", err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no module in [%s]", path)
+}
+
+func writeTemplate(name string, path string, d Day) error {
+	tmpl, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	if err = tmpl.Execute(&buffer, d); err != nil {
+		return err
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("template [%s] is not valid Go: %w", name, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, source, 0644)
+}
+
+// createEmpty creates an empty file unless it already exists, returns true if it was created
+func createEmpty(path string) (bool, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if errors.Is(err, os.ErrExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, file.Close()
+}
+
+// addImport adds a blank import to the (only) import block of the Go file, the imports are kept sorted;
+// returns false if the package is already imported
+func addImport(path string, importPath string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	source := string(data)
+	start := strings.Index(source, "import (\n")
+	if start < 0 {
+		return false, fmt.Errorf("no import block in [%s]", path)
+	}
+	start += len("import (\n")
+	length := strings.Index(source[start:], ")")
+	if length < 0 {
+		return false, fmt.Errorf("unterminated import block in [%s]", path)
+	}
+
+	line := fmt.Sprintf("\t_ %q", importPath)
+	imports := strings.Split(strings.TrimSuffix(source[start:start+length], "\n"), "\n")
+	for _, existing := range imports {
+		if strings.TrimSpace(existing) == strings.TrimSpace(line) {
+			return false, nil
+		}
+	}
+	imports = append(imports, line)
+	sort.Slice(imports, func(i, j int) bool {
+		return strings.TrimSpace(imports[i]) < strings.TrimSpace(imports[j])
+	})
+
+	formatted, err := format.Source([]byte(source[:start] + strings.Join(imports, "\n") + "\n" + source[start+length:]))
+	if err != nil {
+		return false, fmt.Errorf("failed to add the import to [%s]: %w", path, err)
+	}
+	return true, os.WriteFile(path, formatted, 0644)
+}
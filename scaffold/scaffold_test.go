@@ -0,0 +1,54 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":     "module example.com/aoc\n\ngo 1.22\n",
+		RegistryFile: "package main\n\nimport (\n\t_ \"example.com/aoc/y2020\"\n)\n",
+	}
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, day := range []int{7, 3} {
+		if _, err := Create(root, 2021, day); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := Create(root, 2021, 7); err == nil {
+		t.Errorf("existing day created again")
+	}
+
+	want := map[string][]string{
+		"y2021/day07/day07.go":             {"package day07", "solver.Register(2021, 7,", `"example.com/aoc/solver"`},
+		"y2021/day07/day07_test.go":        {"func TestExample(", "func BenchmarkExample(", "func FuzzParse("},
+		"y2021/day07/examples/example.txt": nil,
+		"inputs/2021/day_07.txt":           nil,
+		"y2021/y2021.go":                   {"package y2021", "\t_ \"example.com/aoc/y2021/day03\"\n\t_ \"example.com/aoc/y2021/day07\"\n"},
+		RegistryFile:                       {"\t_ \"example.com/aoc/y2020\"\n\t_ \"example.com/aoc/y2021\"\n)"},
+	}
+	for path, parts := range want {
+		data, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Errorf("%s not created: %s", path, err)
+			continue
+		}
+		for _, part := range parts {
+			if !strings.Contains(string(data), part) {
+				t.Errorf("%s does not contain %q:\n%s", path, part, data)
+			}
+		}
+	}
+}
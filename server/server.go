@@ -0,0 +1,188 @@
+// Package server exposes the registered solvers over HTTP, e.g. POST /2020/day/7/part/2 with the input as the body
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+const DefaultMaxInputSize = 1 << 20 // the real inputs have at most tens of kilobytes
+const DefaultTimeout = 30 * time.Second
+
+// Options of the HTTP handler
+type Options struct {
+	MaxInputSize int64         // larger request bodies are rejected
+	Timeout      time.Duration // limit of parsing and solving a single request
+	// Workers is the number of puzzles solved at once, the requests over it are rejected with 503
+	// (runtime.NumCPU() by default)
+	Workers int
+	// MaxUninterruptible is how many of the workers may solve a puzzle which does not implement solver.Interruptible,
+	// such a solver keeps its worker even after the timeout until it finishes (half of the workers by default)
+	MaxUninterruptible int
+	// Get returns the puzzle of the given day, solver.Get by default
+	Get func(year int, day int) (solver.Puzzle, error)
+}
+
+type handler struct {
+	options         Options
+	workers         chan struct{} // a token for every running solver, including the ones which timed out
+	uninterruptible chan struct{}
+}
+
+// NewHandler returns the handler of the solving endpoint, the zero options get the default values
+func NewHandler(options Options) http.Handler {
+	if options.MaxInputSize <= 0 {
+		options.MaxInputSize = DefaultMaxInputSize
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+	if options.Workers <= 0 {
+		options.Workers = runtime.NumCPU()
+	}
+	if options.MaxUninterruptible <= 0 {
+		options.MaxUninterruptible = max(1, options.Workers/2)
+	}
+	if options.Get == nil {
+		options.Get = solver.Get
+	}
+	h := handler{
+		options:         options,
+		workers:         make(chan struct{}, options.Workers),
+		uninterruptible: make(chan struct{}, min(options.MaxUninterruptible, options.Workers)),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /{year}/day/{day}/part/{part}", h.solve)
+	return mux
+}
+
+// errorResponse is the body of all the failed requests
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h handler) solve(w http.ResponseWriter, r *http.Request) {
+	var numbers [3]int
+	for i, name := range []string{"year", "day", "part"} {
+		number, err := strconv.Atoi(r.PathValue(name))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s [%s]", name, r.PathValue(name)))
+			return
+		}
+		numbers[i] = number
+	}
+	year, day, part := numbers[0], numbers[1], numbers[2]
+	if part != 1 && part != 2 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid part [%d], must be 1 or 2", part))
+		return
+	}
+	puzzle, err := h.options.Get(year, day)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	input, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.options.MaxInputSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("input is larger than %d bytes", tooLarge.Limit))
+			return
+		}
+		writeError(w, http.StatusBadRequest, fmt.Errorf("failed to read input: %w", err))
+		return
+	}
+
+	s := puzzle.New()
+	release, ok := h.acquire(s)
+	if !ok {
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("%s: all the workers are busy, try again later", puzzle))
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), h.options.Timeout)
+	defer cancel()
+	outcome := make(chan response, 1) // buffered, a solver ignoring the context must not block forever
+	go func() {
+		res := solve(ctx, puzzle, s, part, input)
+		release() // only when the solver really finishes, before the response so that the next request finds it free
+		outcome <- res
+	}()
+	select {
+	case res := <-outcome:
+		if res.err != nil {
+			writeError(w, res.status, res.err)
+			return
+		}
+		writeJSON(w, http.StatusOK, res.result)
+	case <-ctx.Done():
+		// the solver does not check the context, it keeps running (and holding its worker) but nobody waits for it
+		writeError(w, http.StatusGatewayTimeout, fmt.Errorf("%s: part %d not solved in %s", puzzle, part, h.options.Timeout))
+	}
+}
+
+// acquire takes a worker for the solver, false if there is none free, the returned function releases it
+func (h handler) acquire(s solver.Solver) (func(), bool) {
+	select {
+	case h.workers <- struct{}{}:
+	default:
+		return nil, false
+	}
+	if _, ok := s.(solver.Interruptible); ok {
+		return func() { <-h.workers }, true
+	}
+	select {
+	case h.uninterruptible <- struct{}{}: // it may run past the timeout, only some of the workers can be held this way
+		return func() { <-h.uninterruptible; <-h.workers }, true
+	default:
+		<-h.workers
+		return nil, false
+	}
+}
+
+type response struct {
+	result solver.Result
+	status int // HTTP status of the error
+	err    error
+}
+
+// solve parses the input and solves the part, a panic of the solver is turned into an internal error
+func solve(ctx context.Context, puzzle solver.Puzzle, s solver.Solver, part int, input []byte) (res response) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = response{status: http.StatusInternalServerError, err: fmt.Errorf("%s: part %d panicked: %v", puzzle, part, r)}
+		}
+	}()
+
+	if err := s.Parse(bytes.NewReader(input)); err != nil {
+		return response{status: http.StatusUnprocessableEntity, err: fmt.Errorf("%s: failed to parse input: %w", puzzle, err)}
+	}
+	result, err := solver.Solve(ctx, puzzle, s, part)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return response{status: http.StatusGatewayTimeout, err: fmt.Errorf("%s: part %d failed: %w", puzzle, part, err)}
+	case err != nil:
+		return response{status: http.StatusUnprocessableEntity, err: fmt.Errorf("%s: part %d failed: %w", puzzle, part, err)}
+	}
+	return response{result: result}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body) // the client is gone if the response cannot be written
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// echoSolver answers with its input, "panic" panics, "slow" blocks until the context is cancelled
+// and "stuck" ignores the context for a while
+type echoSolver struct {
+	solver.ContextHolder
+	input string
+}
+
+func (s *echoSolver) Parse(input io.Reader) error {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("empty input")
+	}
+	s.input = strings.TrimSpace(string(data))
+	return nil
+}
+
+func (s *echoSolver) PartOne() (string, error) {
+	switch s.input {
+	case "panic":
+		panic("exploded")
+	case "slow":
+		<-s.Context().Done()
+		return "", solver.Interrupted(s.Context(), "the end")
+	case "stuck":
+		time.Sleep(200 * time.Millisecond)
+	}
+	return s.input, nil
+}
+
+func (s *echoSolver) PartTwo() (string, error) {
+	return strings.ToUpper(s.input), nil
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(Options{
+		MaxInputSize: 16,
+		Timeout:      50 * time.Millisecond,
+		Workers:      4, // the timed out solvers may still hold theirs for a while
+		Get: func(year int, day int) (solver.Puzzle, error) {
+			if year != 2020 || day != 7 {
+				return solver.Puzzle{}, fmt.Errorf("no solver registered for [%d/%02d]", year, day)
+			}
+			return solver.Puzzle{Year: year, Day: day, New: func() solver.Solver { return &echoSolver{} }}, nil
+		},
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantAnswer string
+	}{
+		{"part one", http.MethodPost, "/2020/day/7/part/1", "bags\n", http.StatusOK, "bags"},
+		{"part two", http.MethodPost, "/2020/day/7/part/2", "bags\n", http.StatusOK, "BAGS"},
+		{"wrong method", http.MethodGet, "/2020/day/7/part/1", "", http.StatusMethodNotAllowed, ""},
+		{"invalid day", http.MethodPost, "/2020/day/seven/part/1", "bags", http.StatusBadRequest, ""},
+		{"invalid part", http.MethodPost, "/2020/day/7/part/3", "bags", http.StatusBadRequest, ""},
+		{"unknown day", http.MethodPost, "/2020/day/8/part/1", "bags", http.StatusNotFound, ""},
+		{"too large", http.MethodPost, "/2020/day/7/part/1", strings.Repeat("x", 17), http.StatusRequestEntityTooLarge, ""},
+		{"invalid input", http.MethodPost, "/2020/day/7/part/1", "", http.StatusUnprocessableEntity, ""},
+		{"panic", http.MethodPost, "/2020/day/7/part/1", "panic", http.StatusInternalServerError, ""},
+		{"interrupted", http.MethodPost, "/2020/day/7/part/1", "slow", http.StatusGatewayTimeout, ""},
+		{"ignoring the context", http.MethodPost, "/2020/day/7/part/1", "stuck", http.StatusGatewayTimeout, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", recorder.Code, tt.wantStatus, recorder.Body)
+			}
+			if tt.wantStatus == http.StatusMethodNotAllowed {
+				return // the response comes from the mux
+			}
+			var body struct {
+				solver.Result
+				Error string `json:"error"`
+			}
+			if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Answer != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", body.Answer, tt.wantAnswer)
+			}
+			if (tt.wantStatus == http.StatusOK) != (body.Error == "") {
+				t.Errorf("unexpected error [%s] for status %d", body.Error, recorder.Code)
+			}
+		})
+	}
+}
+
+// uninterruptibleSolver hides the SetContext method of the wrapped solver
+type uninterruptibleSolver struct {
+	solver.Solver
+}
+
+func TestHandlerBusy(t *testing.T) {
+	handler := NewHandler(Options{
+		Timeout:            50 * time.Millisecond,
+		Workers:            2,
+		MaxUninterruptible: 1,
+		Get: func(year int, day int) (solver.Puzzle, error) {
+			if day == 8 {
+				return solver.Puzzle{Year: year, Day: day, New: func() solver.Solver { return uninterruptibleSolver{&echoSolver{}} }}, nil
+			}
+			return solver.Puzzle{Year: year, Day: day, New: func() solver.Solver { return &echoSolver{} }}, nil
+		},
+	})
+	post := func(day int, body string) int {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, fmt.Sprintf("/2020/day/%d/part/1", day), strings.NewReader(body)))
+		return recorder.Code
+	}
+
+	// the stuck solver times out, but keeps its worker for another 150ms
+	if got := post(8, "stuck"); got != http.StatusGatewayTimeout {
+		t.Fatalf("stuck uninterruptible solver: status = %d, want %d", got, http.StatusGatewayTimeout)
+	}
+	if got := post(8, "bags"); got != http.StatusServiceUnavailable {
+		t.Errorf("second uninterruptible solver: status = %d, want %d", got, http.StatusServiceUnavailable)
+	}
+	if got := post(7, "bags"); got != http.StatusOK {
+		t.Errorf("interruptible solver: status = %d, want %d", got, http.StatusOK)
+	}
+	if got := post(7, "stuck"); got != http.StatusGatewayTimeout {
+		t.Fatalf("stuck interruptible solver: status = %d, want %d", got, http.StatusGatewayTimeout)
+	}
+	if got := post(7, "bags"); got != http.StatusServiceUnavailable {
+		t.Errorf("all workers busy: status = %d, want %d", got, http.StatusServiceUnavailable)
+	}
+
+	time.Sleep(300 * time.Millisecond) // both stuck solvers finish
+	if got := post(8, "bags"); got != http.StatusOK {
+		t.Errorf("after the stuck solvers finished: status = %d, want %d", got, http.StatusOK)
+	}
+}
@@ -0,0 +1,55 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+)
+
+// CheckInterval is how many iterations the long loops run between checking the context (checking it in every
+// iteration would slow the fast loops down)
+const CheckInterval = 1 << 16
+
+// Interruptible is implemented by the solvers with long loops, the loops stop when the context is cancelled
+// (in both parts, the server relies on them to give up their workers after the timeout)
+type Interruptible interface {
+	SetContext(ctx context.Context)
+}
+
+// ContextHolder can be embedded in a solver to implement the Interruptible interface
+type ContextHolder struct {
+	ctx context.Context
+}
+
+func (ch *ContextHolder) SetContext(ctx context.Context) {
+	ch.ctx = ctx
+}
+
+// Context returns the context of the solved part, the background context if none was set
+func (ch *ContextHolder) Context() context.Context {
+	if ch.ctx == nil {
+		return context.Background()
+	}
+	return ch.ctx
+}
+
+// InterruptedError is returned by the solvers stopped by a cancelled context, it tells how far they got
+type InterruptedError struct {
+	Progress string // e.g. "round 1234567 of 10000000"
+	Err      error  // the context error (context.Canceled or context.DeadlineExceeded)
+}
+
+func (e *InterruptedError) Error() string {
+	return fmt.Sprintf("interrupted at %s: %s", e.Progress, e.Err)
+}
+
+func (e *InterruptedError) Unwrap() error {
+	return e.Err
+}
+
+// Interrupted returns an InterruptedError with the progress if the context is done, nil otherwise
+func Interrupted(ctx context.Context, format string, args ...any) error {
+	if err := ctx.Err(); err != nil {
+		return &InterruptedError{Progress: fmt.Sprintf(format, args...), Err: err}
+	}
+	return nil
+}
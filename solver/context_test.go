@@ -0,0 +1,50 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// countingSolver counts until the context is cancelled
+type countingSolver struct {
+	ContextHolder
+}
+
+func (s *countingSolver) Parse(io.Reader) error {
+	return nil
+}
+
+func (s *countingSolver) PartOne() (string, error) {
+	for i := 0; ; i++ {
+		if i%CheckInterval == 0 {
+			if err := Interrupted(s.Context(), "iteration %d", i); err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+func (s *countingSolver) PartTwo() (string, error) {
+	return "done", nil
+}
+
+func TestSolvePartInterrupted(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := SolvePart(ctx, &countingSolver{}, 1)
+	var interrupted *InterruptedError
+	if !errors.As(err, &interrupted) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SolvePart() error = %v, want an InterruptedError with the deadline exceeded", err)
+	}
+	if interrupted.Progress == "" {
+		t.Errorf("InterruptedError has no progress")
+	}
+
+	cancel()
+	if _, err = SolvePart(ctx, &countingSolver{}, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("SolvePart() with a done context error = %v, want the deadline exceeded", err)
+	}
+}
@@ -0,0 +1,34 @@
+package solver
+
+import (
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+)
+
+// FrameFunc receives the state of every iteration of the solved part, the frame must not be modified
+type FrameFunc func(part int, frame *grid.Grid[byte])
+
+// Animator is implemented by the solvers of cellular automata which can report every iteration as a frame
+type Animator interface {
+	Animate(onFrame FrameFunc)
+}
+
+// FrameRecorder can be embedded in a solver to implement the Animator interface
+type FrameRecorder struct {
+	onFrame FrameFunc
+}
+
+func (fr *FrameRecorder) Animate(onFrame FrameFunc) {
+	fr.onFrame = onFrame
+}
+
+// Animating is true if the frames are recorded, so the solver does not need to render them otherwise
+func (fr *FrameRecorder) Animating() bool {
+	return fr.onFrame != nil
+}
+
+// RecordFrame passes the frame to the animation (if there is any)
+func (fr *FrameRecorder) RecordFrame(part int, frame *grid.Grid[byte]) {
+	if fr.onFrame != nil {
+		fr.onFrame(part, frame)
+	}
+}
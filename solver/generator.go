@@ -0,0 +1,72 @@
+package solver
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// Params are the named size parameters of an input generator (e.g. the number of tiles)
+type Params map[string]int
+
+// Check returns an error if the parameter is not within the interval
+func (p Params) Check(name string, min int, max int) error {
+	if p[name] < min || p[name] > max {
+		return fmt.Errorf("parameter [%s] must be between %d and %d, got %d", name, min, max, p[name])
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	items := make([]string, 0, len(p))
+	for name, value := range p {
+		items = append(items, fmt.Sprintf("%s=%d", name, value))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// Generator creates random valid (solvable) inputs of the puzzle
+type Generator struct {
+	Defaults Params // all the supported parameters with their default values
+	Generate func(r *rand.Rand, params Params) (string, error)
+}
+
+var generators = make(map[key]Generator)
+
+// RegisterGenerator adds the input generator of the given day, it is meant to be called from init() of the day package
+func RegisterGenerator(year int, day int, generator Generator) {
+	k := key{year, day}
+	if _, exists := generators[k]; exists {
+		panic(fmt.Errorf("generator for [%d/%02d] registered twice", year, day))
+	}
+	generators[k] = generator
+}
+
+// Generator returns the input generator of the puzzle (if there is any)
+func (p Puzzle) Generator() (Generator, bool) {
+	generator, ok := generators[key{p.Year, p.Day}]
+	return generator, ok
+}
+
+// Generate creates a random input of the puzzle, the same seed and parameters always give the same input
+// (the parameters which are not given get the default values)
+func (p Puzzle) Generate(seed int64, params Params) (string, error) {
+	generator, ok := p.Generator()
+	if !ok {
+		return "", fmt.Errorf("puzzle [%s] has no input generator", p)
+	}
+	all := make(Params, len(generator.Defaults))
+	for name, value := range generator.Defaults {
+		all[name] = value
+	}
+	for name, value := range params {
+		if _, ok := generator.Defaults[name]; !ok {
+			return "", fmt.Errorf("unknown parameter [%s] of the [%s] generator, supported are [%s]", name, p, generator.Defaults)
+		}
+		all[name] = value
+	}
+
+	return generator.Generate(rand.New(rand.NewSource(seed)), all)
+}
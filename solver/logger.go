@@ -0,0 +1,41 @@
+package solver
+
+import (
+	"log/slog"
+)
+
+// LevelTrace is more verbose than debug, for the output too large for the debug level (e.g. every state of a grid)
+const LevelTrace = slog.LevelDebug - 4
+
+// Logged is implemented by the solvers which log how they got the answer (e.g. why an item was rejected)
+type Logged interface {
+	SetLogger(logger *slog.Logger)
+}
+
+// LoggerHolder can be embedded in a solver to implement the Logged interface
+type LoggerHolder struct {
+	logger *slog.Logger
+}
+
+func (lh *LoggerHolder) SetLogger(logger *slog.Logger) {
+	lh.logger = logger
+}
+
+// Logger returns the logger of the solver, the default logger if none was set
+func (lh *LoggerHolder) Logger() *slog.Logger {
+	if lh.logger == nil {
+		return slog.Default()
+	}
+	return lh.logger
+}
+
+// withLogger makes the factory set the default logger (with the puzzle attribute) to the solvers which log
+func withLogger(factory Factory, puzzle string) Factory {
+	return func() Solver {
+		s := factory()
+		if logged, ok := s.(Logged); ok {
+			logged.SetLogger(slog.Default().With("puzzle", puzzle))
+		}
+		return s
+	}
+}
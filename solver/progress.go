@@ -0,0 +1,31 @@
+package solver
+
+// ProgressFunc receives the current step of the solved part and the total number of steps (0 if it is not known
+// in advance), a nil ProgressFunc ignores the reports
+type ProgressFunc func(step int, total int)
+
+// Report passes the progress to the function (if there is any)
+func (f ProgressFunc) Report(step int, total int) {
+	if f != nil {
+		f(step, total)
+	}
+}
+
+// Progressive is implemented by the iterative solvers which can report how far they got
+type Progressive interface {
+	OnProgress(onProgress ProgressFunc)
+}
+
+// ProgressReporter can be embedded in a solver to implement the Progressive interface
+type ProgressReporter struct {
+	onProgress ProgressFunc
+}
+
+func (pr *ProgressReporter) OnProgress(onProgress ProgressFunc) {
+	pr.onProgress = onProgress
+}
+
+// Progress returns the function receiving the progress of the solved part, nil if nobody is interested
+func (pr *ProgressReporter) Progress() ProgressFunc {
+	return pr.onProgress
+}
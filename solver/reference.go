@@ -0,0 +1,28 @@
+package solver
+
+import "fmt"
+
+// Reference is a naive (slow but obviously correct) solver of the puzzle used to cross-check the optimized one
+// on the generated inputs
+type Reference struct {
+	New    Factory
+	Parts  []int  // the parts solved by the reference, the others are left to the optimized solver
+	Params Params // generator parameters keeping the inputs small enough for the reference (nil for the defaults)
+}
+
+var references = make(map[key]Reference)
+
+// RegisterReference adds the reference solver of the given day, it is meant to be called from init() of the day package
+func RegisterReference(year int, day int, reference Reference) {
+	k := key{year, day}
+	if _, exists := references[k]; exists {
+		panic(fmt.Errorf("reference for [%d/%02d] registered twice", year, day))
+	}
+	references[k] = reference
+}
+
+// Reference returns the reference solver of the puzzle (if there is any)
+func (p Puzzle) Reference() (Reference, bool) {
+	reference, ok := references[key{p.Year, p.Day}]
+	return reference, ok
+}
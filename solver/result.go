@@ -0,0 +1,63 @@
+package solver
+
+import (
+	"context"
+	"time"
+)
+
+// Details are optional day-specific facts about the answer (e.g. the winning player)
+type Details map[string]any
+
+// Detailer is implemented by the solvers which describe how they got the answer of the solved part
+type Detailer interface {
+	Details(part int) Details
+}
+
+// DetailsRecorder can be embedded in a solver to implement the Detailer interface
+type DetailsRecorder struct {
+	details map[int]Details
+}
+
+// Record stores the details of the given part, it is meant to be called from PartOne() and PartTwo()
+func (dr *DetailsRecorder) Record(part int, details Details) {
+	if dr.details == nil {
+		dr.details = make(map[int]Details)
+	}
+	dr.details[part] = details
+}
+
+func (dr *DetailsRecorder) Details(part int) Details {
+	return dr.details[part]
+}
+
+// Result is the structured answer of a single part
+type Result struct {
+	Year     int           `json:"year"`
+	Day      int           `json:"day"`
+	Part     int           `json:"part"`
+	Answer   string        `json:"answer"`
+	Duration time.Duration `json:"duration_ns"`
+	Details  Details       `json:"details,omitempty"`
+	Cached   bool          `json:"cached,omitempty"` // the answer was not solved again, the duration is the original one
+}
+
+// Solve solves the given part of the puzzle (the input must be already parsed) and measures how long it took
+func Solve(ctx context.Context, puzzle Puzzle, s Solver, part int) (Result, error) {
+	start := time.Now()
+	answer, err := SolvePart(ctx, s, part)
+	if err != nil {
+		return Result{}, err
+	}
+	result := Result{
+		Year:     puzzle.Year,
+		Day:      puzzle.Day,
+		Part:     part,
+		Answer:   answer,
+		Duration: time.Since(start),
+	}
+	if detailer, ok := s.(Detailer); ok {
+		result.Details = detailer.Details(part)
+	}
+
+	return result, nil
+}
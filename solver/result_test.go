@@ -0,0 +1,48 @@
+package solver
+
+import (
+	"context"
+	"io"
+	"testing"
+)
+
+type detailedSolver struct {
+	DetailsRecorder
+}
+
+func (s *detailedSolver) Parse(io.Reader) error {
+	return nil
+}
+
+func (s *detailedSolver) PartOne() (string, error) {
+	s.Record(1, Details{"winner": 2})
+	return "42", nil
+}
+
+func (s *detailedSolver) PartTwo() (string, error) {
+	return "43", nil
+}
+
+func TestSolve(t *testing.T) {
+	puzzle := Puzzle{Year: 2020, Day: 22}
+	s := &detailedSolver{}
+
+	result, err := Solve(context.Background(), puzzle, s, 1)
+	if err != nil {
+		t.Fatalf("Solve() error: %s", err)
+	}
+	if result.Year != 2020 || result.Day != 22 || result.Part != 1 || result.Answer != "42" {
+		t.Errorf("Solve() = %+v, want 2020/22 part 1 with answer 42", result)
+	}
+	if result.Details["winner"] != 2 {
+		t.Errorf("Solve() details = %v, want winner=2", result.Details)
+	}
+
+	result, err = Solve(context.Background(), puzzle, s, 2)
+	if err != nil {
+		t.Fatalf("Solve() error: %s", err)
+	}
+	if result.Answer != "43" || result.Details != nil {
+		t.Errorf("Solve() = %+v, want answer 43 without details", result)
+	}
+}
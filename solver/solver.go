@@ -0,0 +1,155 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Solver is implemented by every day of every year
+type Solver interface {
+	// Parse reads the puzzle input, it is always called before any of the parts
+	Parse(input io.Reader) error
+	// PartOne returns the answer for the first part of the puzzle
+	PartOne() (string, error)
+	// PartTwo returns the answer for the second part of the puzzle
+	PartTwo() (string, error)
+}
+
+// Factory creates a new (empty) Solver instance
+type Factory func() Solver
+
+// Puzzle is a single registered solution
+type Puzzle struct {
+	Year     int
+	Day      int
+	New      Factory
+	Examples fs.FS // examples from the puzzle description stored as examples/<name>.txt (nil if there are none)
+	Sources  fs.FS // the *.go files of the day package, the version of the solver is derived from them
+}
+
+func (p Puzzle) String() string {
+	return fmt.Sprintf("%d/%02d", p.Year, p.Day)
+}
+
+// InputFile is the path of the puzzle input in the inputs directory, the later editions have their own subdirectories
+// (the inputs of 2020, the first edition in this repository, are directly in it)
+func (p Puzzle) InputFile() string {
+	if p.Year == 2020 {
+		return fmt.Sprintf("day_%02d.txt", p.Day)
+	}
+	return fmt.Sprintf("%d/day_%02d.txt", p.Year, p.Day)
+}
+
+const examplesDir = "examples"
+const exampleExt = ".txt"
+
+// ExampleNames returns the sorted names of all the built-in examples of the puzzle
+func (p Puzzle) ExampleNames() []string {
+	if p.Examples == nil {
+		return nil
+	}
+	files, err := fs.Glob(p.Examples, path.Join(examplesDir, "*"+exampleExt))
+	if err != nil {
+		return nil
+	}
+	result := make([]string, len(files))
+	for i, file := range files {
+		result[i] = strings.TrimSuffix(path.Base(file), exampleExt)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// Example opens the built-in example of the given name
+func (p Puzzle) Example(name string) (io.ReadCloser, error) {
+	if p.Examples != nil {
+		file, err := p.Examples.Open(path.Join(examplesDir, name+exampleExt))
+		if err == nil {
+			return file, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	names := p.ExampleNames()
+	if len(names) == 0 {
+		return nil, fmt.Errorf("puzzle [%s] has no built-in examples", p)
+	}
+	return nil, fmt.Errorf("puzzle [%s] has no example [%s], available examples are [%s]", p, name, strings.Join(names, ", "))
+}
+
+type key struct {
+	year int
+	day  int
+}
+
+var registry = make(map[key]Puzzle)
+
+// Register adds the solution of the given day to the registry, it is meant to be called from init() of the day package
+// together with the embedded examples (or nil) and sources (//go:embed *.go); the new solvers get the default logger
+// if they log
+func Register(year int, day int, factory Factory, examples fs.FS, sources fs.FS) {
+	k := key{year, day}
+	if _, exists := registry[k]; exists {
+		panic(fmt.Errorf("solver for [%d/%02d] registered twice", year, day))
+	}
+	registry[k] = Puzzle{
+		Year:     year,
+		Day:      day,
+		New:      withLogger(factory, fmt.Sprintf("%d/%02d", year, day)),
+		Examples: examples,
+		Sources:  sources,
+	}
+}
+
+// Get returns the puzzle registered for the given day
+func Get(year int, day int) (Puzzle, error) {
+	puzzle, ok := registry[key{year, day}]
+	if !ok {
+		return Puzzle{}, fmt.Errorf("no solver registered for [%d/%02d]", year, day)
+	}
+
+	return puzzle, nil
+}
+
+// All returns all the registered puzzles ordered by year and day
+func All() []Puzzle {
+	result := make([]Puzzle, 0, len(registry))
+	for _, puzzle := range registry {
+		result = append(result, puzzle)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Year != result[j].Year {
+			return result[i].Year < result[j].Year
+		}
+		return result[i].Day < result[j].Day
+	})
+
+	return result
+}
+
+// SolvePart returns the answer for the given part (1 or 2) of the puzzle, the input must be already parsed;
+// the interruptible solvers stop when the context is cancelled
+func SolvePart(ctx context.Context, s Solver, part int) (string, error) {
+	if err := Interrupted(ctx, "start of part %d", part); err != nil {
+		return "", err
+	}
+	if interruptible, ok := s.(Interruptible); ok {
+		interruptible.SetContext(ctx)
+	}
+	switch part {
+	case 1:
+		return s.PartOne()
+	case 2:
+		return s.PartTwo()
+	default:
+		return "", fmt.Errorf("invalid part [%d], must be 1 or 2", part)
+	}
+}
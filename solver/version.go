@@ -0,0 +1,16 @@
+package solver
+
+import (
+	"github.com/tomas-hanicinec/AdventOfCode_2020/source"
+)
+
+// Version identifies the code of the puzzle solver by the hash of the source files of its day package and of the
+// shared packages it imports (without the tests), so every change of the code gets a new version and the cached
+// answers are not used any more; false if the puzzle has no sources
+func (p Puzzle) Version() (string, bool) {
+	hash, ok := source.Hash(p.Sources)
+	if !ok {
+		return "", false
+	}
+	return hash[:12], true
+}
@@ -0,0 +1,32 @@
+package solver
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestVersion(t *testing.T) {
+	sources := fstest.MapFS{
+		"day01.go": {Data: []byte("package day01")},
+		"gen.go":   {Data: []byte("package day01")},
+	}
+	version, ok := Puzzle{Sources: sources}.Version()
+	if !ok || len(version) != 12 {
+		t.Fatalf("Version() = %q, %t, want a 12-character version", version, ok)
+	}
+
+	sources["day01_test.go"] = &fstest.MapFile{Data: []byte("package day01")}
+	if got, _ := (Puzzle{Sources: sources}).Version(); got != version {
+		t.Errorf("Version() with a test = %q, want %q", got, version)
+	}
+	sources["gen.go"] = &fstest.MapFile{Data: []byte("package day01 // changed")}
+	if got, _ := (Puzzle{Sources: sources}).Version(); got == version {
+		t.Errorf("Version() of changed sources = %q, want a new version", got)
+	}
+
+	for _, sources := range []fstest.MapFS{nil, {"input.txt": {Data: []byte("1")}}} {
+		if got, ok := (Puzzle{Sources: sources}).Version(); ok {
+			t.Errorf("Version() without sources = %q, want none", got)
+		}
+	}
+}
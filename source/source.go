@@ -0,0 +1,116 @@
+// Package source keeps the Go files of the shared packages of the module, the version of a solver is derived from
+// the files of its day package together with the shared packages it imports
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var packages = make(map[string]fs.FS)
+
+// Register adds the *.go files of the package with the given import path, it is meant to be called from init()
+// of the package with its embedded files (//go:embed *.go)
+func Register(importPath string, files fs.FS) {
+	if _, exists := packages[importPath]; exists {
+		panic(fmt.Errorf("sources of [%s] registered twice", importPath))
+	}
+	packages[importPath] = files
+}
+
+// Hash is the SHA-256 of the Go files (without the tests) of the package and of all the registered packages
+// it imports, directly or through the other registered packages; false if the package has no Go files
+func Hash(files fs.FS) (string, bool) {
+	if files == nil {
+		return "", false
+	}
+	all := map[string]fs.FS{"": files} // the hashed package is the one without an import path
+	queue := []string{""}
+	for len(queue) > 0 {
+		names, err := goFiles(all[queue[0]])
+		if err != nil || (queue[0] == "" && len(names) == 0) {
+			return "", false
+		}
+		imports, err := getImports(all[queue[0]], names)
+		if err != nil {
+			return "", false
+		}
+		queue = queue[1:]
+		for _, importPath := range imports {
+			if _, done := all[importPath]; done {
+				continue
+			}
+			if imported, ok := packages[importPath]; ok {
+				all[importPath] = imported
+				queue = append(queue, importPath)
+			}
+		}
+	}
+
+	importPaths := make([]string, 0, len(all))
+	for importPath := range all {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	hash := sha256.New()
+	for _, importPath := range importPaths {
+		names, _ := goFiles(all[importPath]) // listed already
+		for _, name := range names {
+			data, err := fs.ReadFile(all[importPath], name)
+			if err != nil {
+				return "", false
+			}
+			hash.Write([]byte(importPath + "/" + name + "\x00"))
+			hash.Write(data)
+			hash.Write([]byte{0})
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// goFiles lists the sorted names of the Go files of the package, the tests are skipped
+func goFiles(files fs.FS) ([]string, error) {
+	names, err := fs.Glob(files, "*.go")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if !strings.HasSuffix(name, "_test.go") {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// getImports returns the import paths of all the given files
+func getImports(files fs.FS, names []string) ([]string, error) {
+	result := make([]string, 0)
+	fileSet := token.NewFileSet()
+	for _, name := range names {
+		data, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fileSet, name, data, parser.ImportsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the imports of [%s]: %w", name, err)
+		}
+		for _, spec := range file.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, importPath)
+		}
+	}
+	return result, nil
+}
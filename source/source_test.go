@@ -0,0 +1,51 @@
+package source
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestHash(t *testing.T) {
+	shared := fstest.MapFS{"shared.go": {Data: []byte("package shared\n\nimport \"example.com/aoc/base\"\n")}}
+	base := fstest.MapFS{"base.go": {Data: []byte("package base")}}
+	Register("example.com/aoc/shared", shared)
+	Register("example.com/aoc/base", base)
+	day := fstest.MapFS{
+		"day01.go":      {Data: []byte("package day01\n\nimport (\n\t\"fmt\"\n\n\t\"example.com/aoc/shared\"\n)\n")},
+		"day01_test.go": {Data: []byte("package day01\n\nimport \"example.com/aoc/unrelated\"\n")},
+	}
+	hash, ok := Hash(day)
+	if !ok {
+		t.Fatal("Hash() = false, want a hash")
+	}
+
+	tests := []struct {
+		name   string
+		change func()
+	}{
+		{"day", func() { day["gen.go"] = &fstest.MapFile{Data: []byte("package day01")} }},
+		{"imported package", func() { shared["shared.go"].Data = append(shared["shared.go"].Data, "// changed"...) }},
+		{"package imported by the imported one", func() { base["base.go"].Data = []byte("package base // changed") }},
+	}
+	for _, tt := range tests {
+		tt.change()
+		got, _ := Hash(day)
+		if got == hash {
+			t.Errorf("Hash() after a change of the %s = %s, want a new hash", tt.name, got)
+		}
+		hash = got
+	}
+
+	day["day01_test.go"].Data = []byte("package day01 // changed")
+	if got, _ := Hash(day); got != hash {
+		t.Errorf("Hash() after a change of a test = %s, want %s", got, hash)
+	}
+}
+
+func TestHashWithoutFiles(t *testing.T) {
+	for _, files := range []fstest.MapFS{nil, {"input.txt": {Data: []byte("1")}}, {"day.go": {Data: []byte("not go")}}} {
+		if got, ok := Hash(files); ok {
+			t.Errorf("Hash(%v) = %s, want none", files, got)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Package testutils contains helpers shared by the tests of the days
+package testutils
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+// ExampleLines reads the lines of the embedded example of the given name
+func ExampleLines(t testing.TB, examples fs.FS, name string) []string {
+	t.Helper()
+	data, err := fs.ReadFile(examples, "examples/"+name+".txt")
+	if err != nil {
+		t.Fatalf("cannot read example [%s]: %s", name, err)
+	}
+
+	return Lines(t, string(data))
+}
+
+// Lines splits the text the same way the puzzle input is split
+func Lines(t testing.TB, text string) []string {
+	t.Helper()
+	lines, err := utils.ReadLines(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return lines
+}
+
+// FuzzParse fuzzes the input parsing of the solver, any input must be either parsed or rejected with an error (never
+// panic); the embedded examples are added to the seed corpus (testdata/fuzz/FuzzParse of the day holds the real input)
+func FuzzParse(f *testing.F, examples fs.FS, newSolver func() solver.Solver) {
+	if examples != nil {
+		names, err := fs.Glob(examples, "examples/*.txt")
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, name := range names {
+			data, err := fs.ReadFile(examples, name)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add(string(data))
+		}
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		_ = newSolver().Parse(strings.NewReader(input))
+	})
+}
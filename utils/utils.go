@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseError describes an invalid part of the puzzle input
+type ParseError struct {
+	File   string // name of the input file (empty if not known)
+	Line   int    // line number starting from 1 (zero if not known)
+	Column int    // column number starting from 1 (zero if not known)
+	Text   string // the offending text
+	Err    error
+}
+
+func NewParseError(column int, text string, err error) *ParseError {
+	return &ParseError{
+		Column: column,
+		Text:   text,
+		Err:    err,
+	}
+}
+
+func (e *ParseError) Error() string {
+	position := make([]string, 0, 3)
+	if e.File != "" {
+		position = append(position, e.File)
+	}
+	if e.Line > 0 {
+		position = append(position, strconv.Itoa(e.Line))
+		if e.Column > 0 {
+			position = append(position, strconv.Itoa(e.Column))
+		}
+	}
+
+	message := e.Err.Error()
+	if e.Text != "" {
+		message = fmt.Sprintf("%s [%s]", message, e.Text)
+	}
+	if len(position) == 0 {
+		return message
+	}
+	return strings.Join(position, ":") + ": " + message
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// AtLine attaches the line number (and the whole line as the offending text if not known yet) to the parse error,
+// any other error is wrapped into a new ParseError
+func AtLine(err error, line int, text string) error {
+	if err == nil {
+		return nil
+	}
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		return &ParseError{
+			Line: line,
+			Text: text,
+			Err:  err,
+		}
+	}
+	if parseError.Line == 0 {
+		parseError.Line = line
+	}
+	if parseError.Text == "" {
+		parseError.Text = text
+	}
+	return err
+}
+
+// OffsetLine shifts the line number of the parse error found in a block of lines which starts after the given offset
+func OffsetLine(err error, offset int) error {
+	var parseError *ParseError
+	if errors.As(err, &parseError) && parseError.Line > 0 {
+		parseError.Line += offset
+	}
+	return err
+}
+
+// InFile attaches the input file name to the parse error, any other error is wrapped into a new ParseError
+func InFile(err error, file string) error {
+	if err == nil {
+		return nil
+	}
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		return &ParseError{
+			File: file,
+			Err:  err,
+		}
+	}
+	if parseError.File == "" {
+		parseError.File = file
+	}
+	return err
+}
+
+// ReadLines reads the whole input split to lines, a single trailing newline does not produce an extra empty line
+// and CRLF line endings are accepted too
+func ReadLines(input io.Reader) ([]string, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n"), nil
+}
+
+// StringsToInts converts lines containing a single number each
+func StringsToInts(lines []string) ([]int, error) {
+	result := make([]int, len(lines))
+	for i, line := range lines {
+		intVal, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, &ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("not a number")}
+		}
+		result[i] = intVal
+	}
+
+	return result, nil
+}
+
+// SplitToInts converts a line of numbers divided by the separator
+func SplitToInts(line string, separator string) ([]int, error) {
+	items := strings.Split(line, separator)
+	result := make([]int, len(items))
+	column := 1
+	for i, item := range items {
+		intVal, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, NewParseError(column, item, fmt.Errorf("not a number"))
+		}
+		result[i] = intVal
+		column += len(item) + len(separator)
+	}
+
+	return result, nil
+}
+
+func AbsInt(val int) int {
+	return int(math.Abs(float64(val)))
+}
+
+func IsInInterval(min, max, index int) bool {
+	return min <= index && index <= max
+}
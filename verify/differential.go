@@ -0,0 +1,139 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// Disagreement is a generated input for which the optimized and the reference solver give different answers
+type Disagreement struct {
+	Puzzle    solver.Puzzle
+	Seed      int64
+	Params    solver.Params // the generator parameters used with the seed
+	Part      int
+	Input     string
+	Optimized string // the answer, or the error prefixed by "error: "
+	Reference string
+}
+
+func (d Disagreement) String() string {
+	return fmt.Sprintf("%s part %d (seed %d):\n  optimized: %s\n  reference: %s\n", d.Puzzle, d.Part, d.Seed, d.Optimized, d.Reference)
+}
+
+// Differential solves the inputs generated from the given seeds by both the optimized and the reference solver of
+// the puzzle and returns the parts where they disagree, an error means the comparison itself failed (e.g. there is
+// no reference or generator, the context is done)
+func Differential(ctx context.Context, puzzle solver.Puzzle, seeds []int64, params solver.Params) ([]Disagreement, error) {
+	reference, ok := puzzle.Reference()
+	if !ok {
+		return nil, fmt.Errorf("puzzle [%s] has no reference solver", puzzle)
+	}
+	all := make(solver.Params, len(reference.Params)+len(params))
+	for name, value := range reference.Params {
+		all[name] = value
+	}
+	for name, value := range params {
+		all[name] = value
+	}
+
+	disagreements := make([]Disagreement, 0)
+	for _, seed := range seeds {
+		input, err := puzzle.Generate(seed, all)
+		if err != nil {
+			return nil, fmt.Errorf("%s: seed %d: %w", puzzle, seed, err)
+		}
+		optimized, err := solveAll(ctx, puzzle.New, input, reference.Parts)
+		if err != nil {
+			return nil, err
+		}
+		expected, err := solveAll(ctx, reference.New, input, reference.Parts)
+		if err != nil {
+			return nil, err
+		}
+		for i, part := range reference.Parts {
+			if optimized[i].agrees(expected[i]) {
+				continue
+			}
+			disagreements = append(disagreements, Disagreement{
+				Puzzle:    puzzle,
+				Seed:      seed,
+				Params:    all,
+				Part:      part,
+				Input:     input,
+				Optimized: optimized[i].String(),
+				Reference: expected[i].String(),
+			})
+		}
+	}
+
+	return disagreements, nil
+}
+
+// answer is the outcome of a single part, a failure to parse or solve it is an outcome too
+type answer struct {
+	value    string
+	err      error
+	panicked bool // the err is the recovered panic
+}
+
+// agrees is true if both answers are the same or both are errors (the messages differ between the solvers),
+// a panic is a bug and never agrees
+func (a answer) agrees(other answer) bool {
+	if a.panicked || other.panicked {
+		return false
+	}
+	if a.err != nil || other.err != nil {
+		return a.err != nil && other.err != nil
+	}
+	return a.value == other.value
+}
+
+func (a answer) String() string {
+	if a.err != nil {
+		return "error: " + a.err.Error()
+	}
+	return a.value
+}
+
+// solveAll returns the answers for the given parts, only an interruption by the context is returned as the error
+// (a panic of the parsing or of a part is recorded as the answer, the other parts are still solved)
+func solveAll(ctx context.Context, factory solver.Factory, input string, parts []int) ([]answer, error) {
+	answers := make([]answer, len(parts))
+	s := factory()
+	if parsed := parse(s, input); parsed.err != nil {
+		parsed.err = fmt.Errorf("failed to parse input: %w", parsed.err)
+		for i := range answers {
+			answers[i] = parsed
+		}
+		return answers, nil
+	}
+	for i, part := range parts {
+		answers[i] = solvePart(ctx, s, part)
+		if answers[i].err != nil && !answers[i].panicked && ctx.Err() != nil {
+			return nil, answers[i].err // interrupted, not an answer
+		}
+	}
+	return answers, nil
+}
+
+func parse(s solver.Solver, input string) (result answer) {
+	defer recoverPanic(&result)
+	result.err = s.Parse(strings.NewReader(input))
+	return result
+}
+
+func solvePart(ctx context.Context, s solver.Solver, part int) (result answer) {
+	defer recoverPanic(&result)
+	result.value, result.err = solver.SolvePart(ctx, s, part)
+	return result
+}
+
+// recoverPanic turns a panic of the deferring function into its answer
+func recoverPanic(result *answer) {
+	if r := recover(); r != nil {
+		*result = answer{err: fmt.Errorf("panic: %v", r), panicked: true}
+	}
+}
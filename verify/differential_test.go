@@ -0,0 +1,78 @@
+package verify
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// panickingSolver panics in the parsing of the input "parse" and in the part one of any other input
+type panickingSolver struct{}
+
+func (s *panickingSolver) Parse(input io.Reader) error {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	if string(data) == "parse" {
+		panic("parse panicked")
+	}
+	return nil
+}
+
+func (s *panickingSolver) PartOne() (string, error) {
+	var numbers []int
+	return strconv.Itoa(numbers[1]), nil
+}
+
+func (s *panickingSolver) PartTwo() (string, error) {
+	return "2", nil
+}
+
+func TestSolveAllPanics(t *testing.T) {
+	factory := func() solver.Solver { return &panickingSolver{} }
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"parse", []string{"error: failed to parse input: panic: parse panicked", "error: failed to parse input: panic: parse panicked"}},
+		{"solve", []string{"error: panic: runtime error: index out of range [1] with length 0", "2"}},
+	}
+	for _, tt := range tests {
+		answers, err := solveAll(context.Background(), factory, tt.input, []int{1, 2})
+		if err != nil {
+			t.Fatalf("solveAll(%q) error: %s", tt.input, err)
+		}
+		got := make([]string, len(answers))
+		for i, answer := range answers {
+			got[i] = answer.String()
+		}
+		if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+			t.Errorf("solveAll(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestAnswerAgrees(t *testing.T) {
+	panicked := answer{err: io.ErrUnexpectedEOF, panicked: true}
+	tests := []struct {
+		a, b answer
+		want bool
+	}{
+		{answer{value: "1"}, answer{value: "1"}, true},
+		{answer{value: "1"}, answer{value: "2"}, false},
+		{answer{err: io.EOF}, answer{err: io.ErrUnexpectedEOF}, true},
+		{answer{err: io.EOF}, answer{value: "1"}, false},
+		{panicked, answer{err: io.EOF}, false},
+		{panicked, panicked, false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.agrees(tt.b); got != tt.want {
+			t.Errorf("%s agrees with %s = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
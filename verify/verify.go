@@ -0,0 +1,92 @@
+// Package verify checks the solutions against the known (golden) answers
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// Answers maps the puzzle (e.g. "2020/07") and the part to the expected answer
+type Answers map[string]map[string]string
+
+func LoadAnswers(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	answers := make(Answers)
+	if err = json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("invalid answers file [%s]: %w", path, err)
+	}
+
+	return answers, nil
+}
+
+// Expected returns the known answer for the given part of the puzzle, false if there is none
+func (a Answers) Expected(puzzle solver.Puzzle, part int) (string, bool) {
+	answer, ok := a[puzzle.String()][strconv.Itoa(part)]
+	return answer, ok
+}
+
+// Result is the outcome of a single verified part
+type Result struct {
+	Puzzle   solver.Puzzle
+	Part     int
+	Expected string
+	Actual   string
+	Err      error // the puzzle failed to parse or solve
+}
+
+func (r Result) Passed() bool {
+	return r.Err == nil && r.Actual == r.Expected
+}
+
+// Diff describes the mismatch between the expected and the actual answer (empty if the part passed)
+func (r Result) Diff() string {
+	if r.Err != nil {
+		return fmt.Sprintf("  - %s\n  ! %s\n", r.Expected, r.Err)
+	}
+	if r.Passed() {
+		return ""
+	}
+	return fmt.Sprintf("  - %s\n  + %s\n", r.Expected, r.Actual)
+}
+
+// Run solves all the parts of the puzzle that have a known answer and compares them,
+// a part interrupted by the context fails with the partial progress as the error
+func Run(ctx context.Context, puzzle solver.Puzzle, input io.Reader, answers Answers) []Result {
+	results := make([]Result, 0, 2)
+	for _, part := range []int{1, 2} {
+		expected, ok := answers.Expected(puzzle, part)
+		if !ok {
+			continue // nothing to compare with
+		}
+		results = append(results, Result{
+			Puzzle:   puzzle,
+			Part:     part,
+			Expected: expected,
+		})
+	}
+	if len(results) == 0 {
+		return results
+	}
+
+	s := puzzle.New()
+	if err := s.Parse(input); err != nil {
+		for i := range results {
+			results[i].Err = fmt.Errorf("failed to parse input: %w", err)
+		}
+		return results
+	}
+	for i := range results {
+		results[i].Actual, results[i].Err = solver.SolvePart(ctx, s, results[i].Part)
+	}
+
+	return results
+}
@@ -0,0 +1,96 @@
+package day01
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const TargetValue = 2020
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 1, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	inputMap *InputMap
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	values, err := utils.StringsToInts(lines)
+	if err != nil {
+		return err
+	}
+	s.inputMap = NewInputMap(values)
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	return s.inputMap.findSumGroup(TargetValue, 2).getProduct()
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	return s.inputMap.findSumGroup(TargetValue, 3).getProduct()
+}
+
+type InputMap map[int]int
+
+func NewInputMap(input []int) *InputMap {
+	inputMap := make(InputMap, len(input))
+	for _, val := range input {
+		inputMap[val] = val
+	}
+
+	return &inputMap
+}
+
+func (im InputMap) findSumGroup(groupSum int, groupSize int) SumGroupMap {
+	for val := range im {
+		if groupSize == 1 {
+			if _, exists := im[groupSum]; exists {
+				return SumGroupMap{groupSum: groupSum}
+			} else {
+				return nil
+			}
+		}
+		// process remainder of the group
+		if tail := im.findSumGroup(groupSum-val, groupSize-1); tail != nil {
+			if _, exists := tail[val]; !exists {
+				// we do not allow using one value multiple times
+				tail[val] = val
+				return tail
+			}
+		}
+	}
+
+	return nil // tried all possibilities, found nothing
+}
+
+type SumGroupMap map[int]int
+
+func (sgm SumGroupMap) getProduct() (string, error) {
+	if sgm == nil {
+		return "", fmt.Errorf("no group of numbers adding up to %d found", TargetValue)
+	}
+	prod := 1
+	for val := range sgm {
+		prod *= val
+	}
+	return strconv.Itoa(prod), nil
+}
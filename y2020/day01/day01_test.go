@@ -0,0 +1,48 @@
+package day01
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+func TestFindSumGroup(t *testing.T) {
+	values, err := utils.StringsToInts(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputMap := NewInputMap(values)
+
+	tests := []struct {
+		name      string
+		groupSize int
+		want      string
+	}{
+		{"part one", 2, "514579"},
+		{"part two", 3, "241861950"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inputMap.findSumGroup(TargetValue, tt.groupSize).getProduct()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("product of the group of %d is [%s], want [%s]", tt.groupSize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindSumGroupNotFound(t *testing.T) {
+	inputMap := NewInputMap([]int{1, 2, 3, 1010})
+	if _, err := inputMap.findSumGroup(TargetValue, 2).getProduct(); err == nil {
+		t.Errorf("a single value must not be used twice in the group")
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
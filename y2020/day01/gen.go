@@ -0,0 +1,74 @@
+package day01
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 1, solver.Generator{
+		Defaults: solver.Params{"count": 200},
+		Generate: generate,
+	})
+}
+
+// generate creates an expense report with exactly one pair and one triple of numbers adding up to the target value,
+// the other numbers are larger than half of the target, so none of them can be in a pair or together in a triple
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 5, TargetValue/4); err != nil {
+		return "", err
+	}
+	for attempt := 0; attempt < 1000; attempt++ {
+		pair := 100 + r.Intn(TargetValue/2-100)
+		tripleA, tripleB := 1+r.Intn(TargetValue/3), 1+r.Intn(TargetValue/3)
+		values := make([]int, 0, params["count"])
+		used := make(map[int]struct{})
+		add := func(value int) {
+			if _, exists := used[value]; !exists {
+				used[value] = struct{}{}
+				values = append(values, value)
+			}
+		}
+		for _, value := range []int{pair, TargetValue - pair, tripleA, tripleB, TargetValue - tripleA - tripleB} {
+			add(value)
+		}
+		for len(values) < params["count"] {
+			add(TargetValue/2 + 1 + r.Intn(TargetValue/2-1))
+		}
+		if countSumGroups(used, 2) != 1 || countSumGroups(used, 3) != 1 {
+			continue // the planted numbers are in another group too, try again
+		}
+
+		lines := make([]string, len(values))
+		for i, value := range gen.Shuffled(r, values) {
+			lines[i] = strconv.Itoa(value)
+		}
+		return gen.Lines(lines), nil
+	}
+
+	return "", fmt.Errorf("failed to generate an expense report with unique sum groups")
+}
+
+// countSumGroups returns the number of distinct pairs (size 2) or triples (size 3) adding up to the target value
+func countSumGroups(values map[int]struct{}, size int) int {
+	count := 0
+	for a := range values {
+		for b := range values {
+			if b <= a {
+				continue
+			}
+			c := TargetValue - a - b
+			if size == 2 && c == 0 {
+				count++
+			}
+			if _, exists := values[c]; size == 3 && exists && c > b {
+				count++
+			}
+		}
+	}
+	return count
+}
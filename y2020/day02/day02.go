@@ -0,0 +1,146 @@
+package day02
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 2, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.LoggerHolder
+	passwords []*Password
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.passwords, err = getPasswords(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	validV1Counter := 0
+	for _, val := range s.passwords {
+		if val.isValidV1(s.Logger()) {
+			validV1Counter++
+		}
+	}
+
+	return strconv.Itoa(validV1Counter), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	validV2Counter := 0
+	for _, val := range s.passwords {
+		if val.isValidV2(s.Logger()) {
+			validV2Counter++
+		}
+	}
+
+	return strconv.Itoa(validV2Counter), nil
+}
+
+type PasswordPolicy struct {
+	a      int
+	b      int
+	letter byte
+}
+
+type Password struct {
+	policy   PasswordPolicy
+	password string
+}
+
+func newPassword(inputLine string) (*Password, error) {
+	pattern := regexp.MustCompile("^([0-9]+)-([0-9]+) ([a-z]): ([a-z]+)$")
+	matches := pattern.FindStringSubmatchIndex(inputLine)
+	if matches == nil {
+		return nil, utils.NewParseError(1, inputLine, fmt.Errorf("invalid password line format"))
+	}
+
+	a, err := strconv.Atoi(inputLine[matches[2]:matches[3]])
+	if err != nil || a < 1 {
+		return nil, utils.NewParseError(matches[2]+1, inputLine[matches[2]:matches[3]], fmt.Errorf("invalid first value"))
+	}
+	b, err := strconv.Atoi(inputLine[matches[4]:matches[5]])
+	if err != nil || b < 1 {
+		return nil, utils.NewParseError(matches[4]+1, inputLine[matches[4]:matches[5]], fmt.Errorf("invalid second value"))
+	}
+
+	return &Password{
+		policy: PasswordPolicy{
+			a:      a,
+			b:      b,
+			letter: inputLine[matches[6]],
+		},
+		password: inputLine[matches[8]:matches[9]],
+	}, nil
+}
+
+func (p Password) isValidV1(logger *slog.Logger) bool {
+	count := 0
+	for i := range p.password {
+		if p.password[i] == p.policy.letter {
+			count++
+		}
+	}
+
+	result := count >= p.policy.a && count <= p.policy.b
+	if !result {
+		logger.Debug("invalid password", "password", p.password, "letter", string(p.policy.letter), "count", count, "min", p.policy.a, "max", p.policy.b)
+	}
+	return result
+}
+
+func (p Password) isValidV2(logger *slog.Logger) bool {
+	count := 0
+	if p.hasLetterAt(p.policy.a) {
+		count++
+	}
+	if p.hasLetterAt(p.policy.b) {
+		count++
+	}
+	result := count == 1
+	if !result {
+		logger.Debug("invalid password", "password", p.password, "letter", string(p.policy.letter), "matches", count, "positions", []int{p.policy.a, p.policy.b})
+	}
+	return result
+}
+
+// hasLetterAt checks the policy letter on the given position (starting from 1), positions outside the password never match
+func (p Password) hasLetterAt(position int) bool {
+	return position <= len(p.password) && p.password[position-1] == p.policy.letter
+}
+
+func getPasswords(lines []string) ([]*Password, error) {
+	result := make([]*Password, len(lines))
+	for i, line := range lines {
+		password, err := newPassword(line)
+		if err != nil {
+			return nil, utils.AtLine(err, i+1, line)
+		}
+		result[i] = password
+	}
+
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package day02
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestPasswordValidity(t *testing.T) {
+	tests := []struct {
+		line    string
+		validV1 bool
+		validV2 bool
+	}{
+		{"1-3 a: abcde", true, true},
+		{"1-3 b: cdefg", false, false},
+		{"2-9 c: ccccccccc", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			password, err := newPassword(tt.line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := password.isValidV1(slog.Default()); got != tt.validV1 {
+				t.Errorf("isValidV1() = %t, want %t", got, tt.validV1)
+			}
+			if got := password.isValidV2(slog.Default()); got != tt.validV2 {
+				t.Errorf("isValidV2() = %t, want %t", got, tt.validV2)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewPassword(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		password, err := newPassword(line)
+		if err != nil {
+			return
+		}
+		password.isValidV1(slog.Default())
+		password.isValidV2(slog.Default())
+	})
+}
@@ -0,0 +1,38 @@
+package day02
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 2, solver.Generator{
+		Defaults: solver.Params{"count": 1000},
+		Generate: generate,
+	})
+}
+
+// generate creates random password policies with passwords which often contain the policy letter
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 100000); err != nil {
+		return "", err
+	}
+	lines := make([]string, params["count"])
+	for i := range lines {
+		a := 1 + r.Intn(10)
+		b := a + 1 + r.Intn(10)
+		letter := 'a' + byte(r.Intn(26))
+		password := []byte(gen.Word(r, a+r.Intn(b-a+6)))
+		for j := range password {
+			if r.Intn(3) == 0 {
+				password[j] = letter
+			}
+		}
+		lines[i] = fmt.Sprintf("%d-%d %c: %s", a, b, letter, password)
+	}
+
+	return gen.Lines(lines), nil
+}
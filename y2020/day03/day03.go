@@ -0,0 +1,99 @@
+package day03
+
+import (
+	"context"
+	"embed"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 3, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.LoggerHolder
+	forrest *Forrest
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if s.forrest, err = NewForrest(lines); err != nil {
+		return err
+	}
+	s.forrest.logger = s.Logger()
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	return strconv.Itoa(s.forrest.traverse(3, 1)), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	increments := [][]int{
+		{1, 1},
+		{3, 1},
+		{5, 1},
+		{7, 1},
+		{1, 2},
+	}
+	result := 1
+	for _, increment := range increments {
+		result *= s.forrest.traverse(increment[0], increment[1])
+	}
+
+	return strconv.Itoa(result), nil
+}
+
+type Forrest struct {
+	trees  *grid.Grid[byte]
+	logger *slog.Logger
+}
+
+func NewForrest(lines []string) (*Forrest, error) {
+	trees, err := grid.ParseBytes(lines, "#.")
+	if err != nil {
+		return nil, err
+	}
+	trees.Edge = grid.WrapColumns // the same pattern repeats to the right many times
+
+	return &Forrest{trees: trees, logger: slog.Default()}, nil
+}
+
+func (f Forrest) traverse(incrementX int, incrementY int) int {
+	position := grid.Point{}
+	increment := grid.Point{Row: incrementY, Column: incrementX}
+	treeCounter := 0
+	debug := f.logger.Enabled(context.Background(), slog.LevelDebug) // checked once, the steps are many
+	for {
+		position = position.Add(increment)
+		cell, ok := f.trees.Get(position)
+		if !ok {
+			break // reached the bottom of the forrest
+		}
+		if cell == '#' {
+			treeCounter++
+		}
+		if debug {
+			f.logger.Debug("traversal step", "slope", increment, "position", position, "tree", cell == '#', "trees", treeCounter)
+		}
+	}
+
+	return treeCounter
+}
@@ -0,0 +1,36 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestTraverse(t *testing.T) {
+	forrest, err := NewForrest(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		incrementX int
+		incrementY int
+		want       int
+	}{
+		{1, 1, 2},
+		{3, 1, 7},
+		{5, 1, 3},
+		{7, 1, 4},
+		{1, 2, 2},
+	}
+	for _, tt := range tests {
+		if got := forrest.traverse(tt.incrementX, tt.incrementY); got != tt.want {
+			t.Errorf("traverse(%d, %d) = %d, want %d", tt.incrementX, tt.incrementY, got, tt.want)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
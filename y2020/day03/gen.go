@@ -0,0 +1,30 @@
+package day03
+
+import (
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 3, solver.Generator{
+		Defaults: solver.Params{"width": 31, "height": 323, "density": 25},
+		Generate: generate,
+	})
+}
+
+// generate creates a random forrest, density is the percentage of trees
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("width", 1, 10000); err != nil {
+		return "", err
+	}
+	if err := params.Check("height", 1, 100000); err != nil {
+		return "", err
+	}
+	if err := params.Check("density", 0, 100); err != nil {
+		return "", err
+	}
+
+	return gen.Lines(gen.Pattern(r, params["width"], params["height"], float64(params["density"])/100, '#', '.')), nil
+}
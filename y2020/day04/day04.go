@@ -1,26 +1,62 @@
-package main
+package day04
 
 import (
+	"embed"
 	"fmt"
+	"io"
+	"log/slog"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
-func main() {
-	passports := readPassports()
-	validCounterV1, validCounterV2 := 0, 0
-	for _, passport := range passports {
-		if passport.isValid(ValidateV1) {
-			validCounterV1++
-		}
-		if passport.isValid(ValidateV2) {
-			validCounterV2++
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 4, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.LoggerHolder
+	passports []*Passport
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.passports, err = readPassports(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	return strconv.Itoa(s.countValidPassports(ValidateV1)), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	return strconv.Itoa(s.countValidPassports(ValidateV2)), nil
+}
+
+func (s *Solver) countValidPassports(validator Validator) int {
+	validCounter := 0
+	for _, passport := range s.passports {
+		if passport.isValid(validator, s.Logger()) {
+			validCounter++
 		}
 	}
 
-	fmt.Printf("V1 total valid passports: %d\n", validCounterV1) // Part I.
-	fmt.Printf("V2 total valid passports: %d\n", validCounterV2) // Part II.
+	return validCounter
 }
 
 type Passport struct {
@@ -28,36 +64,36 @@ type Passport struct {
 	fields map[string]string
 }
 
-func readPassports() []*Passport {
-	lines := ReadLines("inputs/day_04.txt")
-
+func readPassports(lines []string) ([]*Passport, error) {
 	result := make([]*Passport, 0)
-	lastNewlineIndex := -1
-	passportCounter := 0
-	for i, line := range lines {
-		if line == "" {
-			// newline, process the previous passport
-			passportCounter++
-			result = append(result, newPassport(passportCounter, lines[lastNewlineIndex+1:i]))
-			lastNewlineIndex = i
+	for i, block := range records.Split(lines) {
+		passport, err := newPassport(i+1, block.Lines)
+		if err != nil {
+			return nil, block.Locate(err)
 		}
+		result = append(result, passport)
 	}
 
-	return result
+	return result, nil
 }
 
-func newPassport(number int, lines []string) *Passport {
+func newPassport(number int, lines []string) (*Passport, error) {
 	// Get passport skeleton
 	passport := getEmptyPassport(number)
 	// Fill fields from input
-	for _, line := range lines {
+	for i, line := range lines {
+		column := 1
 		for _, fieldChunk := range strings.Split(line, " ") {
 			field := strings.Split(fieldChunk, ":")
+			if len(field) != 2 || strings.TrimSpace(field[0]) == "" {
+				return nil, &utils.ParseError{Line: i + 1, Column: column, Text: fieldChunk, Err: fmt.Errorf("invalid passport field, expected [code:value]")}
+			}
 			passport.fields[strings.TrimSpace(field[0])] = strings.TrimSpace(field[1])
+			column += len(fieldChunk) + 1
 		}
 	}
 
-	return passport
+	return passport, nil
 }
 
 func getEmptyPassport(number int) *Passport {
@@ -80,10 +116,10 @@ func (p *Passport) setField(code string, value string) {
 	p.fields[code] = value
 }
 
-func (p *Passport) isValid(validator Validator) bool {
+func (p *Passport) isValid(validator Validator, logger *slog.Logger) bool {
 	for code, value := range p.fields {
 		if err := validator(code, value); err != nil {
-			//fmt.Printf("Passport #%d not valid. Field [%s] validation error: %s\n", p.number, code, err)
+			logger.Debug("invalid passport", "number", p.number, "field", code, "value", value, "error", err)
 			return false
 		}
 	}
@@ -0,0 +1,100 @@
+package day04
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestCountValidPassports(t *testing.T) {
+	tests := []struct {
+		example string
+		wantV1  int
+		wantV2  int
+	}{
+		{"example", 2, 2},
+		{"invalid", 4, 0},
+		{"valid", 4, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.example, func(t *testing.T) {
+			passports, err := readPassports(testutils.ExampleLines(t, examples, tt.example))
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := &Solver{passports: passports}
+			if got := s.countValidPassports(ValidateV1); got != tt.wantV1 {
+				t.Errorf("valid passports (v1) = %d, want %d", got, tt.wantV1)
+			}
+			if got := s.countValidPassports(ValidateV2); got != tt.wantV2 {
+				t.Errorf("valid passports (v2) = %d, want %d", got, tt.wantV2)
+			}
+		})
+	}
+}
+
+func TestIsValidLogsReason(t *testing.T) {
+	passports, err := readPassports(testutils.Lines(t, "ecl:gry pid:860033327 eyr:2020 hcl:#fffffd byr:1937 iyr:2017 cid:147\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logs strings.Builder
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	if passports[0].isValid(ValidateV1, logger) {
+		t.Fatalf("passport without height must not be valid")
+	}
+	if !strings.Contains(logs.String(), `msg="invalid passport" number=1 field=hgt value="" error="empty mandatory value"`) {
+		t.Errorf("missing height not logged, got %s", logs.String())
+	}
+}
+
+func TestValidateV2(t *testing.T) {
+	tests := []struct {
+		field string
+		value string
+		valid bool
+	}{
+		{"byr", "2002", true},
+		{"byr", "2003", false},
+		{"hgt", "60in", true},
+		{"hgt", "190cm", true},
+		{"hgt", "190in", false},
+		{"hgt", "190", false},
+		{"hcl", "#123abc", true},
+		{"hcl", "#123abz", false},
+		{"hcl", "123abc", false},
+		{"ecl", "brn", true},
+		{"ecl", "wat", false},
+		{"pid", "000000001", true},
+		{"pid", "0123456789", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field+":"+tt.value, func(t *testing.T) {
+			err := ValidateV2(tt.field, tt.value)
+			if tt.valid && err != nil {
+				t.Errorf("expected valid, got [%s]", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected invalid")
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewPassport(f *testing.F) {
+	f.Fuzz(func(t *testing.T, block string) {
+		passport, err := newPassport(1, strings.Split(block, "\n"))
+		if err != nil {
+			return
+		}
+		passport.isValid(ValidateV1, slog.Default())
+		passport.isValid(ValidateV2, slog.Default())
+	})
+}
@@ -0,0 +1,97 @@
+package day04
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 4, solver.Generator{
+		Defaults: solver.Params{"count": 300},
+		Generate: generate,
+	})
+}
+
+var eyeColors = []string{"amb", "blu", "brn", "gry", "grn", "hzl", "oth"}
+
+// fieldValues generate a valid and an invalid value of each field
+var fieldValues = map[string][2]func(r *rand.Rand) string{
+	"byr": {yearValue(1920, 2002), yearValue(1880, 1919)},
+	"iyr": {yearValue(2010, 2020), yearValue(2021, 2040)},
+	"eyr": {yearValue(2020, 2030), yearValue(1990, 2019)},
+	"hgt": {
+		func(r *rand.Rand) string {
+			if r.Intn(2) == 0 {
+				return fmt.Sprintf("%dcm", 150+r.Intn(44))
+			}
+			return fmt.Sprintf("%din", 59+r.Intn(18))
+		},
+		func(r *rand.Rand) string {
+			return []string{fmt.Sprintf("%d", 150+r.Intn(44)), fmt.Sprintf("%dcm", 194+r.Intn(50)), fmt.Sprintf("%din", 20+r.Intn(39))}[r.Intn(3)]
+		},
+	},
+	"hcl": {
+		func(r *rand.Rand) string { return fmt.Sprintf("#%06x", r.Intn(1<<24)) },
+		func(r *rand.Rand) string {
+			return []string{fmt.Sprintf("%06x", r.Intn(1<<24)), "#" + gen.Word(r, 6)}[r.Intn(2)]
+		},
+	},
+	"ecl": {
+		func(r *rand.Rand) string { return eyeColors[r.Intn(len(eyeColors))] },
+		func(r *rand.Rand) string { return gen.Word(r, 2+r.Intn(3)) },
+	},
+	"pid": {
+		func(r *rand.Rand) string { return fmt.Sprintf("%09d", r.Intn(1000000000)) },
+		func(r *rand.Rand) string { return fmt.Sprintf("%d", r.Intn(100000000)+r.Intn(2)*1000000000) },
+	},
+	"cid": {
+		func(r *rand.Rand) string { return fmt.Sprintf("%d", 50+r.Intn(300)) },
+		func(r *rand.Rand) string { return fmt.Sprintf("%d", 50+r.Intn(300)) },
+	},
+}
+
+func yearValue(min int, max int) func(r *rand.Rand) string {
+	return func(r *rand.Rand) string {
+		return fmt.Sprintf("%d", min+r.Intn(max-min+1))
+	}
+}
+
+// generate creates passports with randomly missing fields and invalid values, the fields are spread to 1-4 lines
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 100000); err != nil {
+		return "", err
+	}
+	codes := []string{"byr", "iyr", "eyr", "hgt", "hcl", "ecl", "pid", "cid"}
+	passports := make([]string, params["count"])
+	for i := range passports {
+		fields := make([]string, 0, len(codes))
+		for _, code := range gen.Shuffled(r, codes) {
+			if r.Float64() < 0.1 || (code == "cid" && r.Intn(2) == 0) {
+				continue // missing field
+			}
+			valid := 0
+			if r.Float64() < 0.1 {
+				valid = 1
+			}
+			fields = append(fields, code+":"+fieldValues[code][valid](r))
+		}
+		if len(fields) == 0 {
+			fields = append(fields, "cid:"+fieldValues["cid"][0](r)) // a passport cannot be empty (it would be a separator)
+		}
+
+		var sb strings.Builder
+		for j, field := range fields {
+			if j > 0 {
+				sb.WriteString([]string{" ", " ", "\n"}[r.Intn(3)])
+			}
+			sb.WriteString(field)
+		}
+		passports[i] = sb.String()
+	}
+
+	return gen.Lines([]string{strings.Join(passports, "\n\n")}), nil
+}
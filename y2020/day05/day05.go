@@ -0,0 +1,175 @@
+package day05
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 5, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.LoggerHolder
+	boardingTickets []*BoardingTicket
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.boardingTickets, err = readBoardingTickets(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	maxId := 0
+	for _, ticket := range s.boardingTickets {
+		currentId := ticket.getSeatId(s.Logger())
+		if currentId > maxId {
+			maxId = currentId
+		}
+	}
+
+	return strconv.Itoa(maxId), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	seatIds := make([]int, len(s.boardingTickets))
+	for i, ticket := range s.boardingTickets {
+		seatIds[i] = ticket.getSeatId(s.Logger())
+	}
+
+	sort.Ints(seatIds)
+	previousSeatId := seatIds[0]
+	for i := 1; i < len(seatIds); i++ {
+		if seatIds[i]-previousSeatId == 2 {
+			return strconv.Itoa(seatIds[i] - 1), nil
+		}
+		previousSeatId = seatIds[i]
+	}
+
+	return "", fmt.Errorf("no free seat found")
+}
+
+type BoardingTicket struct {
+	number     int
+	binaryCode string
+}
+
+func readBoardingTickets(lines []string) ([]*BoardingTicket, error) {
+	result := make([]*BoardingTicket, len(lines))
+	for i, line := range lines {
+		ticket, err := newBoardingTicket(line)
+		if err != nil {
+			return nil, utils.AtLine(err, i+1, line)
+		}
+		result[i] = ticket
+	}
+
+	return result, nil
+}
+
+func newBoardingTicket(binaryCode string) (*BoardingTicket, error) {
+	if len(binaryCode) != 10 {
+		return nil, utils.NewParseError(1, binaryCode, fmt.Errorf("binary code must have exactly 10 letters"))
+	}
+	for i := range binaryCode {
+		allowed := "FB" // row letters
+		if i >= 7 {
+			allowed = "LR" // column letters
+		}
+		if strings.IndexByte(allowed, binaryCode[i]) < 0 {
+			return nil, utils.NewParseError(i+1, binaryCode[i:i+1], fmt.Errorf("unknown letter in binary code, expected one of [%s]", allowed))
+		}
+	}
+
+	return &BoardingTicket{
+		binaryCode: binaryCode,
+	}, nil
+}
+
+func (bt BoardingTicket) getRow(logger *slog.Logger) int {
+	rowInterval := interval{
+		a: 0,
+		b: 127,
+	}
+	return parseBinaryInterval(logger, bt.binaryCode[0:7], rowInterval, 'F', 'B')
+}
+
+func (bt BoardingTicket) getColumn(logger *slog.Logger) int {
+	columnInterval := interval{
+		a: 0,
+		b: 7,
+	}
+	return parseBinaryInterval(logger, bt.binaryCode[7:10], columnInterval, 'L', 'R')
+}
+
+func parseBinaryInterval(logger *slog.Logger, binaryCode string, fullInterval interval, codeLow uint8, codeUp uint8) int {
+	currentInterval := fullInterval
+	debug := logger.Enabled(context.Background(), slog.LevelDebug)
+	if debug {
+		logger.Debug("binary interval", "code", binaryCode, "interval", currentInterval)
+	}
+	for i := 0; i < len(binaryCode); i++ {
+		if binaryCode[i] == codeLow {
+			currentInterval = currentInterval.getLowerHalf()
+		} else if binaryCode[i] == codeUp {
+			currentInterval = currentInterval.getUpperHalf()
+		} else {
+			panic(fmt.Errorf("unknown input letter %s in binary code %s", string(binaryCode[i]), binaryCode)) // unreachable, newBoardingTicket checks the letters
+		}
+		if debug {
+			logger.Debug("binary interval", "code", binaryCode, "letter", string(binaryCode[i]), "interval", currentInterval)
+		}
+	}
+	if currentInterval.a != currentInterval.b {
+		panic(fmt.Errorf("invalid interval [%d, %d] in the end of parsing for binary code %s", currentInterval.a, currentInterval.b, binaryCode)) // unreachable, newBoardingTicket checks the length
+	}
+	return currentInterval.a
+}
+
+func (bt BoardingTicket) getSeatId(logger *slog.Logger) int {
+	return bt.getRow(logger)*8 + bt.getColumn(logger)
+}
+
+type interval struct {
+	a int
+	b int
+}
+
+func (i interval) String() string {
+	return fmt.Sprintf("[%d, %d]", i.a, i.b)
+}
+
+func (i interval) getLowerHalf() interval {
+	return interval{
+		a: i.a,
+		b: i.b - (i.b-i.a)/2 - 1, // 7 / 2 = 3 in Go...
+	}
+}
+
+func (i interval) getUpperHalf() interval {
+	return interval{
+		a: i.a + (i.b-i.a)/2 + 1,
+		b: i.b,
+	}
+}
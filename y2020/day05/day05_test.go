@@ -0,0 +1,71 @@
+package day05
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestBoardingTicket(t *testing.T) {
+	tests := []struct {
+		code   string
+		row    int
+		column int
+		seatId int
+	}{
+		{"FBFBBFFRLR", 44, 5, 357},
+		{"BFFFBBFRRR", 70, 7, 567},
+		{"FFFBBBFRRR", 14, 7, 119},
+		{"BBFFBBFRLL", 102, 4, 820},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			ticket, err := newBoardingTicket(tt.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ticket.getRow(slog.Default()); got != tt.row {
+				t.Errorf("getRow() = %d, want %d", got, tt.row)
+			}
+			if got := ticket.getColumn(slog.Default()); got != tt.column {
+				t.Errorf("getColumn() = %d, want %d", got, tt.column)
+			}
+			if got := ticket.getSeatId(slog.Default()); got != tt.seatId {
+				t.Errorf("getSeatId() = %d, want %d", got, tt.seatId)
+			}
+		})
+	}
+}
+
+func TestFreeSeat(t *testing.T) {
+	tickets, err := readBoardingTickets([]string{"FFFFFFBLRR", "FFFFFFBLLL", "FFFFFFBLLR"}) // seats 11, 8 and 9
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Solver{boardingTickets: tickets}
+	got, err := s.PartTwo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "10" {
+		t.Errorf("free seat is [%s], want [10]", got)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewBoardingTicket(f *testing.F) {
+	f.Fuzz(func(t *testing.T, code string) {
+		ticket, err := newBoardingTicket(code)
+		if err != nil {
+			return
+		}
+		if id := ticket.getSeatId(slog.Default()); id < 0 || id > 1023 {
+			t.Errorf("getSeatId() = %d, want a value between 0 and 1023", id)
+		}
+	})
+}
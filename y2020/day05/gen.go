@@ -0,0 +1,53 @@
+package day05
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 5, solver.Generator{
+		Defaults: solver.Params{"count": 800},
+		Generate: generate,
+	})
+}
+
+// generate creates boarding passes of a contiguous range of seats with exactly one seat missing in the middle
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 3, 1000); err != nil {
+		return "", err
+	}
+	count := params["count"]
+	first := 8 + r.Intn(1024-16-count) // some seats at the very front and back do not exist
+	mySeat := first + 1 + r.Intn(count-2)
+	lines := make([]string, 0, count)
+	for id := first; id <= first+count; id++ {
+		if id != mySeat {
+			lines = append(lines, getBoardingPass(id))
+		}
+	}
+
+	return gen.Lines(gen.Shuffled(r, lines)), nil
+}
+
+// getBoardingPass encodes the seat id in binary, F and L are zeros, B and R are ones
+func getBoardingPass(id int) string {
+	var sb strings.Builder
+	for bit := 9; bit >= 0; bit-- {
+		one := id&(1<<bit) != 0
+		switch {
+		case bit >= 3 && one:
+			sb.WriteByte('B')
+		case bit >= 3:
+			sb.WriteByte('F')
+		case one:
+			sb.WriteByte('R')
+		default:
+			sb.WriteByte('L')
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,118 @@
+package day06
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 6, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	groupAnswers []*GroupAnswer
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.groupAnswers, err = readGroupAnswers(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	totalResultAny := 0
+	for _, groupAnswer := range s.groupAnswers {
+		totalResultAny += groupAnswer.getAnswerCountAny()
+	}
+
+	return strconv.Itoa(totalResultAny), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	totalResultAll := 0
+	for _, groupAnswer := range s.groupAnswers {
+		totalResultAll += groupAnswer.getAnswerCountAll()
+	}
+
+	return strconv.Itoa(totalResultAll), nil
+}
+
+type GroupAnswer struct {
+	id             int
+	size           int
+	answerCountMap map[uint8]int
+}
+
+func readGroupAnswers(lines []string) ([]*GroupAnswer, error) {
+	result := make([]*GroupAnswer, 0)
+	for i, block := range records.Split(lines) {
+		groupAnswer, err := NewGroupAnswer(i+1, block.Lines)
+		if err != nil {
+			return nil, block.Locate(err)
+		}
+		result = append(result, groupAnswer)
+	}
+
+	return result, nil
+}
+
+func NewGroupAnswer(groupId int, groupLines []string) (*GroupAnswer, error) {
+	answerCountMap := make(map[uint8]int)
+	for i, line := range groupLines {
+		for j := range line {
+			if line[j] < 'a' || line[j] > 'z' {
+				return nil, &utils.ParseError{Line: i + 1, Column: j + 1, Text: line[j : j+1], Err: fmt.Errorf("question must be a lowercase letter")}
+			}
+			if strings.IndexByte(line[:j], line[j]) >= 0 {
+				return nil, &utils.ParseError{Line: i + 1, Column: j + 1, Text: line[j : j+1], Err: fmt.Errorf("question answered twice by the same person")}
+			}
+			answerCountMap[line[j]]++
+		}
+	}
+
+	return &GroupAnswer{
+		id:             groupId,
+		size:           len(groupLines),
+		answerCountMap: answerCountMap,
+	}, nil
+}
+
+func (ga GroupAnswer) getAnswerCountAny() int {
+	result := 0
+	for _, count := range ga.answerCountMap {
+		if count > 0 {
+			result++ // Al least one person from group answered yes to this question
+		}
+	}
+
+	return result
+}
+
+func (ga GroupAnswer) getAnswerCountAll() int {
+	result := 0
+	for _, count := range ga.answerCountMap {
+		if count == ga.size {
+			result++ // All people from group answered yes to this question
+		}
+	}
+
+	return result
+}
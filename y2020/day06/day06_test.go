@@ -0,0 +1,33 @@
+package day06
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGroupAnswerCounts(t *testing.T) {
+	groupAnswers, err := readGroupAnswers(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantAny := []int{3, 3, 3, 1, 1}
+	wantAll := []int{3, 0, 1, 1, 1}
+	if len(groupAnswers) != len(wantAny) {
+		t.Fatalf("got %d groups, want %d", len(groupAnswers), len(wantAny))
+	}
+	for i, groupAnswer := range groupAnswers {
+		if got := groupAnswer.getAnswerCountAny(); got != wantAny[i] {
+			t.Errorf("group %d: getAnswerCountAny() = %d, want %d", i+1, got, wantAny[i])
+		}
+		if got := groupAnswer.getAnswerCountAll(); got != wantAll[i] {
+			t.Errorf("group %d: getAnswerCountAll() = %d, want %d", i+1, got, wantAll[i])
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
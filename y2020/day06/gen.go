@@ -0,0 +1,47 @@
+package day06
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 6, solver.Generator{
+		Defaults: solver.Params{"groups": 480, "people": 5},
+		Generate: generate,
+	})
+}
+
+// generate creates groups of 1 to the given number of people, the answers of a group partially overlap
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("groups", 1, 100000); err != nil {
+		return "", err
+	}
+	if err := params.Check("people", 1, 100); err != nil {
+		return "", err
+	}
+	questions := []byte("abcdefghijklmnopqrstuvwxyz")
+	groups := make([]string, params["groups"])
+	for i := range groups {
+		common := gen.Subset(r, questions, 0.2)
+		people := make([]string, 1+r.Intn(params["people"]))
+		for j := range people {
+			answers := make([]byte, 0)
+			for _, question := range questions {
+				if strings.IndexByte(string(common), question) >= 0 || r.Float64() < 0.1 {
+					answers = append(answers, question)
+				}
+			}
+			if len(answers) == 0 {
+				answers = append(answers, questions[r.Intn(len(questions))]) // an empty line would be a separator
+			}
+			people[j] = string(gen.Shuffled(r, answers))
+		}
+		groups[i] = strings.Join(people, "\n")
+	}
+
+	return gen.Lines([]string{strings.Join(groups, "\n\n")}), nil
+}
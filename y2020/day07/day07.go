@@ -0,0 +1,179 @@
+package day07
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const myBagColor = "shiny gold"
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 7, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	bagRules map[BagColor]*BagRule
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.bagRules, err = readBagRules(lines)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.bagRules[myBagColor]; !ok {
+		return fmt.Errorf("no rule for my bag color [%s]", myBagColor)
+	}
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	result := make(map[BagColor]bool)
+	traverseGraphUpwards(myBagColor, s.bagRules, result)
+	return strconv.Itoa(len(result) - 1), nil // Exclude the root (myBagColor) from the result
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	result, err := traverseGraphDownwards(myBagColor, s.bagRules, 1)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(result - 1), nil // Exclude the root (myBagColor) from the result
+}
+
+type BagColor string
+
+type BagRule struct {
+	children map[BagColor]int
+	parents  map[BagColor]int
+}
+
+func (br *BagRule) addParent(color BagColor, count int) {
+	br.parents[color] = count
+}
+
+func readBagRules(lines []string) (map[BagColor]*BagRule, error) {
+	bagRules := make(map[BagColor]*BagRule, len(lines))
+
+	// Fill "children" links (parent bag can contain child bag)
+	for i, line := range lines {
+		color, children, err := parseLine(line)
+		if err != nil {
+			return nil, utils.AtLine(err, i+1, line)
+		}
+		if _, exists := bagRules[color]; exists {
+			return nil, &utils.ParseError{Line: i + 1, Column: 1, Text: string(color), Err: fmt.Errorf("duplicate rule for bag color")}
+		}
+		bagRules[color] = &BagRule{
+			children: children,
+			parents:  make(map[BagColor]int),
+		}
+	}
+
+	// Fill "parent" links (child bag can be contained by parent bag)
+	for color, bagRule := range bagRules {
+		for childColor, count := range bagRule.children {
+			if _, exists := bagRules[childColor]; !exists {
+				return nil, fmt.Errorf("bag color [%s] contained in [%s] has no rule", childColor, color)
+			}
+			bagRules[childColor].parents[color] = count
+		}
+	}
+
+	return bagRules, nil
+}
+
+func parseLine(line string) (BagColor, map[BagColor]int, error) {
+	linePattern := regexp.MustCompile("^([a-z]+ [a-z]+) bags contain (.+)\\.$")
+	bagPattern := regexp.MustCompile("^([0-9]+) ([a-z]+ [a-z]+) bags?$")
+	noOtherBagsPattern := "no other bags"
+	lineMatches, err := getMatches(line, linePattern)
+	if err != nil {
+		return "", nil, utils.NewParseError(1, line, err)
+	}
+	bagRuleItems := make(map[BagColor]int)
+	if lineMatches[1] != noOtherBagsPattern {
+		column := len(lineMatches[0]) + len(" bags contain ") + 1
+		for _, containedBag := range strings.Split(lineMatches[1], ", ") {
+			bagMatches, err := getMatches(containedBag, bagPattern)
+			if err != nil {
+				return "", nil, utils.NewParseError(column, containedBag, err)
+			}
+			bagCount, err := strconv.Atoi(bagMatches[0])
+			if err != nil {
+				return "", nil, utils.NewParseError(column, bagMatches[0], fmt.Errorf("invalid bag count"))
+			}
+			bagRuleItems[BagColor(bagMatches[1])] = bagCount
+			column += len(containedBag) + len(", ")
+		}
+	}
+
+	return BagColor(lineMatches[0]), bagRuleItems, nil
+}
+
+func getMatches(input string, pattern *regexp.Regexp) ([]string, error) {
+	matches := pattern.FindStringSubmatch(input)
+	if len(matches) < 1 {
+		return nil, fmt.Errorf("failed to match pattern [%s]", pattern)
+	}
+
+	return matches[1:], nil
+}
+
+func traverseGraphUpwards(color BagColor, graph map[BagColor]*BagRule, result map[BagColor]bool) {
+	currentRule, exists := graph[color]
+	if !exists {
+		panic(fmt.Errorf("logic error: bag color [%s] not present in bag rules", color)) // unreachable, readBagRules checks all the contained colors have a rule
+	}
+
+	if _, exists = result[color]; exists {
+		return // This color is already in the map, no need to traverse further (would lead to infinite loop anyway)
+	}
+
+	result[color] = true
+
+	// Handle parents
+	for parentColor := range currentRule.parents {
+		traverseGraphUpwards(parentColor, graph, result)
+	}
+}
+
+func traverseGraphDownwards(color BagColor, graph map[BagColor]*BagRule, currentDepth int) (int, error) {
+	if currentDepth > len(graph) {
+		return 0, fmt.Errorf("bag [%s] contains itself, the number of bags inside is infinite", color) // deeper than the number of colors means a loop
+	}
+	currentRule, exists := graph[color]
+	if !exists {
+		panic(fmt.Errorf("logic error: bag color [%s] not present in bag rules", color)) // unreachable, readBagRules checks all the contained colors have a rule
+	}
+	result := 1 // Include this bag as well
+
+	// Handle children
+	for childColor, count := range currentRule.children {
+		val, err := traverseGraphDownwards(childColor, graph, currentDepth+1)
+		if err != nil {
+			return 0, err
+		}
+		result += count * val // Add total number of bags in "childColor" bag (including the childColorBag)
+	}
+
+	return result, nil // Total number of bags in "color" bag (including itself)
+}
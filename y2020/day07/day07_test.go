@@ -0,0 +1,74 @@
+package day07
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestBagRules(t *testing.T) {
+	tests := []struct {
+		example     string
+		wantParents int
+		wantInside  int
+	}{
+		{"example", 4, 32},
+		{"example2", 0, 126},
+	}
+	for _, tt := range tests {
+		t.Run(tt.example, func(t *testing.T) {
+			bagRules, err := readBagRules(testutils.ExampleLines(t, examples, tt.example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parents := make(map[BagColor]bool)
+			traverseGraphUpwards(myBagColor, bagRules, parents)
+			if got := len(parents) - 1; got != tt.wantParents {
+				t.Errorf("bags containing [%s] = %d, want %d", myBagColor, got, tt.wantParents)
+			}
+			inside, err := traverseGraphDownwards(myBagColor, bagRules, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := inside - 1; got != tt.wantInside {
+				t.Errorf("bags inside [%s] = %d, want %d", myBagColor, got, tt.wantInside)
+			}
+		})
+	}
+}
+
+func TestTraverseGraphDownwardsLoop(t *testing.T) {
+	bagRules, err := readBagRules([]string{
+		"shiny gold bags contain 1 dark red bag.",
+		"dark red bags contain 2 shiny gold bags.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = traverseGraphDownwards(myBagColor, bagRules, 1); err == nil {
+		t.Errorf("traverseGraphDownwards() of a loop must return an error")
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzParseLine(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		color, children, err := parseLine(line)
+		if err != nil {
+			return
+		}
+		if color == "" {
+			t.Errorf("parseLine(%q) returned an empty color", line)
+		}
+		for child, count := range children {
+			if child == "" || count < 0 {
+				t.Errorf("parseLine(%q) returned an invalid content [%d %s]", line, count, child)
+			}
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package day07
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 7, solver.Generator{
+		Defaults: solver.Params{"colors": 600, "layers": 8, "children": 4},
+		Generate: generate,
+	})
+}
+
+// generate creates the rules of a layered graph (bags contain only bags from the deeper layers, so there are no loops),
+// my bag is in the middle layer so it has both the parents and the children
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("layers", 2, 10); err != nil {
+		return "", err
+	}
+	if err := params.Check("colors", params["layers"], 10000); err != nil {
+		return "", err
+	}
+	if err := params.Check("children", 1, 6); err != nil {
+		return "", err
+	}
+
+	words := gen.Words(r, 2*params["colors"], 3, 7)
+	layers := make([][]string, params["layers"])
+	for i := 0; i < params["colors"]; i++ {
+		layer := i % len(layers) // every layer gets at least one color
+		layers[layer] = append(layers[layer], words[2*i]+" "+words[2*i+1])
+	}
+	middle := len(layers) / 2
+	layers[middle][0] = myBagColor
+
+	lines := make([]string, 0, params["colors"])
+	for i, layer := range layers {
+		for _, color := range layer {
+			contents := make([]string, 0)
+			if i < len(layers)-1 && r.Intn(5) > 0 {
+				used := make(map[string]struct{})
+				for j := 0; j < 1+r.Intn(params["children"]); j++ {
+					deeper := layers[i+1+r.Intn(len(layers)-i-1)]
+					child := deeper[r.Intn(len(deeper))]
+					if _, exists := used[child]; exists {
+						continue
+					}
+					used[child] = struct{}{}
+					count := 1 + r.Intn(5)
+					bags := "bags"
+					if count == 1 {
+						bags = "bag"
+					}
+					contents = append(contents, fmt.Sprintf("%d %s %s", count, child, bags))
+				}
+			}
+			if len(contents) == 0 {
+				contents = append(contents, "no other bags")
+			}
+			lines = append(lines, fmt.Sprintf("%s bags contain %s.", color, strings.Join(contents, ", ")))
+		}
+	}
+
+	return gen.Lines(gen.Shuffled(r, lines)), nil
+}
@@ -1,49 +1,96 @@
-package main
+package day08
 
 import (
+	"embed"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
-func main() {
-	bootCode := NewBootCode()
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 8, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.DetailsRecorder
+	bootCode BootCode
+}
 
-	finished, result, _ := bootCode.run(0, -1)
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.bootCode, err = NewBootCode(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	finished, result, _, err := s.bootCode.run(0, -1)
+	if err != nil {
+		return "", err
+	}
 	if finished {
-		panic(fmt.Errorf("given boot code was not supposed to finish without correction"))
+		return "", fmt.Errorf("given boot code was not supposed to finish without correction")
 	}
-	fmt.Printf("Accumulator value before infinite loop: %d\n", result) // Part I.
 
-	for instructionIndexToRepair := range bootCode.instructions {
-		if !bootCode.canRepairInstruction(instructionIndexToRepair) {
+	return strconv.Itoa(result), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	for instructionIndexToRepair := range s.bootCode.instructions {
+		if !s.bootCode.canRepairInstruction(instructionIndexToRepair) {
 			continue // This instruction cannot be repaired, no need to run the whole boot code
 		}
-		finished, result, _ := bootCode.run(0, instructionIndexToRepair)
+		finished, result, _, err := s.bootCode.run(0, instructionIndexToRepair)
+		if err != nil {
+			continue // this repair makes the boot code jump out of the program, it is not the right one
+		}
 		if finished {
-			fmt.Printf("Repaired instruction #%d, boot code finished with accumulator value: %d\n", instructionIndexToRepair, result) // Part II.
-			return
+			instruction := s.bootCode.instructions[instructionIndexToRepair]
+			s.Record(2, solver.Details{
+				"repaired_index":       instructionIndexToRepair,
+				"repaired_instruction": fmt.Sprintf("%s %+d", instruction.operationCode, instruction.argument),
+			})
+			return strconv.Itoa(result), nil
 		}
 	}
 
-	panic(fmt.Errorf("no fix to the given boot code found (always ends in infinite loop)"))
+	return "", fmt.Errorf("no fix to the given boot code found (always ends in infinite loop)")
 }
 
 type BootCode struct {
 	instructions []*Instruction
 }
 
-func NewBootCode() BootCode {
-	lines := ReadLines("inputs/day_08.txt")
-
+func NewBootCode(lines []string) (BootCode, error) {
 	bc := BootCode{
 		instructions: make([]*Instruction, len(lines)),
 	}
+	definitions := getInstructionOperationDefinitions()
 	for i, line := range lines {
 		parsed := strings.Split(line, " ")
+		if len(parsed) != 2 {
+			return BootCode{}, &utils.ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("instruction must be in format [operation argument]")}
+		}
+		if _, exists := definitions[parsed[0]]; !exists {
+			return BootCode{}, &utils.ParseError{Line: i + 1, Column: 1, Text: parsed[0], Err: fmt.Errorf("unknown operation")}
+		}
 		argument, err := strconv.Atoi(parsed[1])
 		if err != nil {
-			panic(fmt.Errorf("invalid argument [%s] for operation [%s]", parsed[1], parsed[0]))
+			return BootCode{}, &utils.ParseError{Line: i + 1, Column: len(parsed[0]) + 2, Text: parsed[1], Err: fmt.Errorf("invalid argument for operation [%s]", parsed[0])}
 		}
 		bc.instructions[i] = &Instruction{
 			operationCode: parsed[0],
@@ -51,17 +98,17 @@ func NewBootCode() BootCode {
 		}
 	}
 
-	return bc
+	return bc, nil
 }
 
-func (bc BootCode) run(instructionIndex int, instructionIndexToRepair int) (bool, int, []string) {
+func (bc BootCode) run(instructionIndex int, instructionIndexToRepair int) (bool, int, []string, error) {
 	visitedInstructions := make([]bool, len(bc.instructions))
 	accumulator := 0
 	currentInstructionIndex := instructionIndex
 	runLog := make([]string, 0)
 	for currentInstructionIndex < len(bc.instructions) {
 		if visitedInstructions[currentInstructionIndex] == true {
-			return false, accumulator, runLog // We already processed this instruction -> infinite loop
+			return false, accumulator, runLog, nil // We already processed this instruction -> infinite loop
 		}
 		repairCurrentInstruction := instructionIndexToRepair == currentInstructionIndex
 		instructionOffset, accOffset := bc.instructions[currentInstructionIndex].process(repairCurrentInstruction)
@@ -74,19 +121,23 @@ func (bc BootCode) run(instructionIndex int, instructionIndexToRepair int) (bool
 		))
 		visitedInstructions[currentInstructionIndex] = true
 		accumulator += accOffset
-		currentInstructionIndex = bc.getNextInstructionIndex(currentInstructionIndex, instructionOffset)
+		nextInstructionIndex, err := bc.getNextInstructionIndex(currentInstructionIndex, instructionOffset)
+		if err != nil {
+			return false, accumulator, runLog, err
+		}
+		currentInstructionIndex = nextInstructionIndex
 	}
 
-	return true, accumulator, runLog // currentInstructionIndex at the end of instruction file -> successful finish
+	return true, accumulator, runLog, nil // currentInstructionIndex at the end of instruction file -> successful finish
 }
 
-func (bc BootCode) getNextInstructionIndex(currentInstructionIndex int, instructionOffset int) int {
+func (bc BootCode) getNextInstructionIndex(currentInstructionIndex int, instructionOffset int) (int, error) {
 	newIndex := currentInstructionIndex + instructionOffset
 	if newIndex < 0 || newIndex > len(bc.instructions) {
-		panic(fmt.Errorf("instruction index overflow (from [%d] jump by [%d] to [%d])", currentInstructionIndex, instructionOffset, newIndex))
+		return 0, fmt.Errorf("instruction index overflow (from [%d] jump by [%d] to [%d])", currentInstructionIndex, instructionOffset, newIndex)
 	}
 
-	return newIndex
+	return newIndex, nil
 }
 
 func (bc BootCode) canRepairInstruction(instructionIndexToRepair int) bool {
@@ -109,7 +160,7 @@ func (i Instruction) process(repairInstruction bool) (int, int) {
 func (i Instruction) getRepaired() Instruction {
 	definition := i.getOperationDefinition()
 	if !definition.canRepair() {
-		panic(fmt.Errorf("instruction [%s %d] cannot be repaired", i.operationCode, i.argument))
+		panic(fmt.Errorf("instruction [%s %d] cannot be repaired", i.operationCode, i.argument)) // unreachable, PartTwo repairs only after canRepairInstruction
 	}
 	return Instruction{
 		operationCode: definition.repairedCode,
@@ -121,7 +172,7 @@ func (i Instruction) getOperationDefinition() InstructionOperationDefinition {
 	definitions := getInstructionOperationDefinitions()
 	definition, exists := definitions[i.operationCode]
 	if !exists {
-		panic(fmt.Errorf("unknown instruction [%s]", i.operationCode))
+		panic(fmt.Errorf("unknown instruction [%s]", i.operationCode)) // unreachable, NewBootCode checks the operations
 	}
 
 	return definition
@@ -0,0 +1,41 @@
+package day08
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestRun(t *testing.T) {
+	bootCode, err := NewBootCode(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		indexToRepair   int
+		wantFinished    bool
+		wantAccumulator int
+	}{
+		{"no repair", -1, false, 5},
+		{"repair jmp -4", 7, true, 8},
+		{"repair nop +0", 0, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finished, accumulator, _, err := bootCode.run(0, tt.indexToRepair)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if finished != tt.wantFinished || accumulator != tt.wantAccumulator {
+				t.Errorf("run() = (%t, %d), want (%t, %d)", finished, accumulator, tt.wantFinished, tt.wantAccumulator)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
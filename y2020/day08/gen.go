@@ -0,0 +1,145 @@
+package day08
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 8, solver.Generator{
+		Defaults: solver.Params{"count": 600},
+		Generate: generate,
+	})
+}
+
+const maxAttempts = 1000
+
+// generate creates a terminating boot code and corrupts one jump on its path, the result must end in an infinite loop
+// and exactly one repair must make it terminate (otherwise a new boot code is tried)
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 10, 100000); err != nil {
+		return "", err
+	}
+	count := params["count"]
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		instructions, path := getTerminatingCode(r, count)
+		onPath := make(map[int]bool, len(path))
+		for _, index := range path {
+			onPath[index] = true
+		}
+		corrupted := -1
+		for i := len(path) - 2; i >= len(path)/2; i-- { // corrupt a jump close to the end, so the rest of the path is short
+			if instructions[path[i]].operationCode == "jmp" && !onPath[path[i]+1] {
+				corrupted = path[i]
+				break
+			}
+		}
+		if corrupted < 0 {
+			continue
+		}
+		instructions[corrupted].operationCode = "nop"
+		if !hasSingleRepair(instructions) {
+			continue
+		}
+
+		lines := make([]string, len(instructions))
+		for i, instruction := range instructions {
+			lines[i] = fmt.Sprintf("%s %+d", instruction.operationCode, instruction.argument)
+		}
+		return gen.Lines(lines), nil
+	}
+
+	return "", fmt.Errorf("no boot code with a single repair found in %d attempts", maxAttempts)
+}
+
+// getTerminatingCode creates instructions with a path from the first instruction to the end of the code, the rest of
+// the instructions never get to the path (they only jump between themselves)
+func getTerminatingCode(r *rand.Rand, count int) ([]*Instruction, []int) {
+	// the path visits a random subset of the instructions in a random order and ends right after the last instruction
+	path := append([]int{0}, gen.Subset(r, gen.Shuffled(r, makeRange(1, count)), 0.2)...)
+	path = append(path, count)
+	onPath := make(map[int]bool, len(path))
+	for _, index := range path {
+		onPath[index] = true
+	}
+	offPath := make([]int, 0, count-len(path))
+	for i := 0; i < count; i++ {
+		if !onPath[i] {
+			offPath = append(offPath, i)
+		}
+	}
+	randomJump := func(index int) int {
+		if len(offPath) == 0 {
+			return 1
+		}
+		return offPath[r.Intn(len(offPath))] - index
+	}
+
+	instructions := make([]*Instruction, count)
+	for _, i := range offPath {
+		switch {
+		case onPath[i+1] || r.Intn(3) == 0:
+			instructions[i] = &Instruction{operationCode: "jmp", argument: randomJump(i)}
+		case r.Intn(2) == 0:
+			instructions[i] = &Instruction{operationCode: "acc", argument: r.Intn(101) - 50}
+		default:
+			instructions[i] = &Instruction{operationCode: "nop", argument: randomJump(i)}
+		}
+	}
+	for i := 0; i < len(path)-1; i++ {
+		offset := path[i+1] - path[i]
+		if offset == 1 && r.Intn(2) == 0 {
+			instructions[path[i]] = &Instruction{operationCode: "acc", argument: r.Intn(101) - 50}
+		} else if offset == 1 {
+			instructions[path[i]] = &Instruction{operationCode: "nop", argument: randomJump(path[i])}
+		} else {
+			instructions[path[i]] = &Instruction{operationCode: "jmp", argument: offset}
+		}
+	}
+
+	return instructions, path
+}
+
+// hasSingleRepair checks the boot code ends in an infinite loop and exactly one jmp/nop swap makes it terminate
+func hasSingleRepair(instructions []*Instruction) bool {
+	if terminates(instructions, -1) {
+		return false
+	}
+	repairs := 0
+	for i, instruction := range instructions {
+		if instruction.operationCode != "acc" && terminates(instructions, i) {
+			repairs++
+		}
+	}
+	return repairs == 1
+}
+
+// terminates runs the boot code with the given instruction swapped (jmp/nop), jumping out of the code does not count
+func terminates(instructions []*Instruction, swapped int) bool {
+	visited := make([]bool, len(instructions))
+	index := 0
+	for index >= 0 && index < len(instructions) && !visited[index] {
+		visited[index] = true
+		operationCode := instructions[index].operationCode
+		if index == swapped {
+			operationCode = map[string]string{"jmp": "nop", "nop": "jmp"}[operationCode]
+		}
+		if operationCode == "jmp" {
+			index += instructions[index].argument
+		} else {
+			index++
+		}
+	}
+	return index == len(instructions)
+}
+
+func makeRange(from int, to int) []int {
+	result := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		result = append(result, i)
+	}
+	return result
+}
@@ -0,0 +1,150 @@
+package day09
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const preambleSize = 25
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 9, func() solver.Solver {
+		return &Solver{}
+	}, nil, sources) // the example uses a shorter preamble, it cannot be run with the real solver
+}
+
+type Solver struct {
+	xmasCode XmasCode
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.xmasCode, err = NewXmasCode(lines)
+	if err != nil {
+		return err
+	}
+	if len(s.xmasCode) <= preambleSize {
+		return fmt.Errorf("XmasCode must be longer than the preamble (%d numbers)", preambleSize)
+	}
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	_, weaknessSum, found := s.xmasCode.findWeaknessSum(preambleSize)
+	if !found {
+		return "", fmt.Errorf("no weakness sum found in XmasCode")
+	}
+
+	return strconv.FormatInt(weaknessSum, 10), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	_, weaknessSum, found := s.xmasCode.findWeaknessSum(preambleSize)
+	if !found {
+		return "", fmt.Errorf("no weakness sum found in XmasCode")
+	}
+	_, _, weakness, found := s.xmasCode.findWeakness(weaknessSum)
+	if !found {
+		return "", fmt.Errorf("no contiguous set for XmasCode weakness found")
+	}
+
+	return strconv.FormatInt(weakness, 10), nil
+}
+
+type XmasCode []int64
+
+func NewXmasCode(lines []string) (XmasCode, error) {
+	return StringsToLongints(lines)
+}
+
+func (xc XmasCode) findWeaknessSum(preambleSize int) (int, int64, bool) {
+	for i := preambleSize; i < len(xc); i++ {
+		_, _, found := xc.findSum(xc[i], xc[i-preambleSize:i])
+		if !found {
+			return i, xc[i], true
+		}
+
+	}
+
+	return 0, 0, false
+}
+
+func (xc XmasCode) findWeakness(weaknessSum int64) (int, int, int64, bool) {
+	startIndex, stopIndex, sum := 0, 1, xc[0]
+	for stopIndex < len(xc) {
+		if sum == weaknessSum && startIndex < stopIndex {
+			// Solution found
+			minValue, maxValue := GetMinMax(xc[startIndex:stopIndex])
+			return startIndex, stopIndex, minValue + maxValue, true
+		}
+
+		if sum > weaknessSum {
+			// Sum too big, remove the first number
+			sum -= xc[startIndex]
+			startIndex++
+		} else {
+			// Sum not big enough, add next number
+			sum += xc[stopIndex]
+			stopIndex++
+		}
+	}
+
+	return 0, 0, 0, false
+}
+
+func (xc XmasCode) findSum(sum int64, values []int64) (int64, int64, bool) {
+	// O(n) complexity (2 passes)
+	numberMap := make(map[int64]bool, len(values))
+	remainders := make([]int64, len(values))
+	for i, value := range values {
+		numberMap[value] = true
+		remainders[i] = sum - value
+	}
+
+	for _, remainder := range remainders {
+		if _, exists := numberMap[remainder]; exists {
+			return remainder, sum - remainder, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+func StringsToLongints(strings []string) ([]int64, error) {
+	result := make([]int64, len(strings))
+	for i, stringVal := range strings {
+		intVal, err := strconv.ParseInt(stringVal, 10, 64)
+		if err != nil {
+			return nil, &utils.ParseError{Line: i + 1, Column: 1, Text: stringVal, Err: fmt.Errorf("not a number")}
+		}
+		result[i] = intVal
+	}
+
+	return result, nil
+}
+
+func GetMinMax(values []int64) (int64, int64) {
+	if len(values) < 1 {
+		panic(fmt.Errorf("cannot get Min, Max from empty array")) // unreachable, findWeakness passes only non-empty ranges
+	}
+	minVal := int64(math.MaxInt64)
+	maxVal := int64(math.MinInt64)
+	for _, val := range values {
+		minVal = int64(math.Min(float64(minVal), float64(val)))
+		maxVal = int64(math.Max(float64(maxVal), float64(val)))
+	}
+
+	return minVal, maxVal
+}
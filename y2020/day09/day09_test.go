@@ -0,0 +1,50 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+// the example uses a preamble of 5 numbers (instead of 25)
+const exampleInput = `35
+20
+15
+25
+47
+40
+62
+55
+65
+95
+102
+117
+150
+182
+127
+219
+299
+277
+309
+576`
+
+func TestFindWeakness(t *testing.T) {
+	xmasCode, err := NewXmasCode(testutils.Lines(t, exampleInput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, weaknessSum, found := xmasCode.findWeaknessSum(5)
+	if !found || weaknessSum != 127 {
+		t.Fatalf("findWeaknessSum() = (%d, %t), want (127, true)", weaknessSum, found)
+	}
+	_, _, weakness, found := xmasCode.findWeakness(weaknessSum)
+	if !found || weakness != 62 {
+		t.Errorf("findWeakness() = (%d, %t), want (62, true)", weakness, found)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, nil, func() solver.Solver { return &Solver{} })
+}
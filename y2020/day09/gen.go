@@ -0,0 +1,100 @@
+package day09
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 9, solver.Generator{
+		Defaults: solver.Params{"count": 1000},
+		Generate: generate,
+	})
+}
+
+const maxAttempts = 1000
+
+// generate creates a sequence where every number is a sum of two different numbers from the preceding window except
+// one, which is a sum of a contiguous range of the previous numbers instead
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", preambleSize+2, 1200); err != nil {
+		return "", err
+	}
+	count := params["count"]
+	numbers := make(XmasCode, 0, count)
+	for _, value := range gen.Shuffled(r, makeRange(1, 2*preambleSize+1))[:preambleSize] {
+		numbers = append(numbers, int64(value))
+	}
+	weaknessIndex := preambleSize + (count-preambleSize)/2 + r.Intn((count-preambleSize+1)/2)
+	for len(numbers) < count {
+		window := numbers[len(numbers)-preambleSize:]
+		next, ok := int64(0), false
+		if len(numbers) == weaknessIndex {
+			next, ok = getWeakness(r, numbers, window)
+		} else {
+			next, ok = getValidNumber(r, window)
+		}
+		if !ok {
+			return "", fmt.Errorf("failed to generate the number at index [%d]", len(numbers))
+		}
+		numbers = append(numbers, next)
+	}
+
+	lines := make([]string, len(numbers))
+	for i, number := range numbers {
+		lines[i] = strconv.FormatInt(number, 10)
+	}
+	return gen.Lines(lines), nil
+}
+
+// getValidNumber returns a sum of two of the smaller numbers of the window (so the sequence grows slowly), the sum is
+// not in the window yet
+func getValidNumber(r *rand.Rand, window XmasCode) (int64, bool) {
+	sorted := append(XmasCode{}, window...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		a, b := sorted[r.Intn(len(sorted)/2)], sorted[r.Intn(len(sorted)/2)]
+		if a != b && !contains(window, a+b) {
+			return a + b, true
+		}
+	}
+	return 0, false
+}
+
+// getWeakness returns a sum of a contiguous range of the numbers, the sum must not be a sum of any two numbers in the window
+func getWeakness(r *rand.Rand, numbers XmasCode, window XmasCode) (int64, bool) {
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		length := 2 + r.Intn(15)
+		start := r.Intn(len(numbers) - length)
+		sum := int64(0)
+		for _, number := range numbers[start : start+length] {
+			sum += number
+		}
+		if _, _, found := numbers.findSum(sum, window); !found && !contains(window, sum) {
+			return sum, true
+		}
+	}
+	return 0, false
+}
+
+func contains(values XmasCode, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func makeRange(from int, to int) []int {
+	result := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		result = append(result, i)
+	}
+	return result
+}
@@ -1,55 +1,90 @@
-package main
+package day10
 
 import (
+	"embed"
 	"fmt"
+	"io"
 	"sort"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
-func main() {
-	adapterChain := NewAdapters()
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 10, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	adapterChain AdapterChain
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	adapters, err := utils.StringsToInts(lines)
+	if err != nil {
+		return err
+	}
+	s.adapterChain = NewAdapters(adapters)
+	return nil
+}
 
-	// Part I.
-	d1, d2, d3 := adapterChain.getGapDistribution()
-	fmt.Printf("Adapter gap distribution: [%d, %d, %d] -> result: %d\n", d1, d2, d3, d1*d3)
+func (s *Solver) PartOne() (string, error) {
+	d1, _, d3, err := s.adapterChain.getGapDistribution()
+	if err != nil {
+		return "", err
+	}
 
-	// Part II.
-	result := adapterChain.getCombinations()
-	fmt.Printf("Number of possible chain combinations: %d\n", result)
+	return strconv.Itoa(d1 * d3), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	return strconv.FormatInt(s.adapterChain.getCombinations(), 10), nil
 }
 
 type AdapterChain []int
 
-func NewAdapters() AdapterChain {
-	adapters := StringsToInts(ReadLines("inputs/day_10.txt"))
+func NewAdapters(adapters []int) AdapterChain {
 	sort.Ints(adapters)
 	adapters = append(adapters, adapters[len(adapters)-1]+3) // Add the internal device adapter
 	return append([]int{0}, adapters...)                     // Add the built-in charging socket
 }
 
-func (a AdapterChain) getGapDistribution() (int, int, int) {
+func (a AdapterChain) getGapDistribution() (int, int, int, error) {
 	gapCounter := make([]int, 3)
 	previousValue := a[0] // built-in charging socket
 	for i := 1; i < len(a); i++ {
 		diff := a[i] - previousValue
 		if diff < 1 || diff > 3 {
-			panic(fmt.Errorf("difference between [%d] and [%d] is [%d], cannot chain all the adapters", previousValue, a[i], diff))
+			return 0, 0, 0, fmt.Errorf("difference between [%d] and [%d] is [%d], cannot chain all the adapters", previousValue, a[i], diff)
 		}
 		gapCounter[diff-1]++
 		previousValue = a[i]
 	}
 
-	return gapCounter[0], gapCounter[1], gapCounter[2]
+	return gapCounter[0], gapCounter[1], gapCounter[2], nil
 }
 
 func (a AdapterChain) getCombinations() int64 {
 	sequenceStartIndex := 1
 	totalCombinations := int64(1)
-	for i := 1; i < len(a)-1; i++ {
+	for i := 1; i < len(a); i++ {
 		// split the array to sub-sequences divided by numbers differing by 3 (such sub-sequences are independent to each other in terms of chain combinations)
 		if a[i]-a[i-1] > 2 {
 			// end of sequence -  process the sequence separately
 			// each sequence has at least one solution (leave as-is, do not skip any items), more solutions / possible skips multiply the number of all solutions
-			totalCombinations *= a.getSequenceLinkCombinations(sequenceStartIndex, sequenceStartIndex-1, i+1) // sequence contains the last number, with the difference of 3
+			totalCombinations *= a.getSequenceLinkCombinations(sequenceStartIndex, sequenceStartIndex-1, i) // sequence ends with the number after the difference of 3 (it cannot be skipped), the last one is the internal device adapter
 			sequenceStartIndex = i + 1
 		}
 	}
@@ -0,0 +1,48 @@
+package day10
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+func TestAdapterChain(t *testing.T) {
+	tests := []struct {
+		example          string
+		wantD1           int
+		wantD3           int
+		wantCombinations int64
+	}{
+		{"example", 7, 5, 8},
+		{"example2", 22, 10, 19208},
+	}
+	for _, tt := range tests {
+		t.Run(tt.example, func(t *testing.T) {
+			adapters, err := utils.StringsToInts(testutils.ExampleLines(t, examples, tt.example))
+			if err != nil {
+				t.Fatal(err)
+			}
+			adapterChain := NewAdapters(adapters)
+
+			d1, _, d3, err := adapterChain.getGapDistribution()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d1 != tt.wantD1 || d3 != tt.wantD3 {
+				t.Errorf("getGapDistribution() = (%d, %d), want (%d, %d)", d1, d3, tt.wantD1, tt.wantD3)
+			}
+			if got := adapterChain.getCombinations(); got != tt.wantCombinations {
+				t.Errorf("getCombinations() = %d, want %d", got, tt.wantCombinations)
+			}
+			if got := adapterChain.countChains(); got != tt.wantCombinations {
+				t.Errorf("countChains() = %d, want %d", got, tt.wantCombinations)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
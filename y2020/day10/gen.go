@@ -0,0 +1,42 @@
+package day10
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 10, solver.Generator{
+		Defaults: solver.Params{"count": 100},
+		Generate: generate,
+	})
+}
+
+const maxRun = 4 // the longest sequence of gaps smaller than 3 (keeps the number of combinations within int64)
+
+// generate creates a chain of adapters with the gaps of 1, 2 and 3 jolts
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 100); err != nil {
+		return "", err
+	}
+	lines := make([]string, params["count"])
+	joltage, run := 0, 0
+	for i := range lines {
+		gap := 3
+		if run < maxRun && r.Intn(10) < 7 {
+			gap = 1 + r.Intn(10)/9 // mostly 1, rarely 2
+		}
+		if gap == 3 {
+			run = 0
+		} else {
+			run++
+		}
+		joltage += gap
+		lines[i] = strconv.Itoa(joltage)
+	}
+
+	return gen.Lines(gen.Shuffled(r, lines)), nil
+}
@@ -0,0 +1,37 @@
+package day10
+
+import (
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterReference(2020, 10, solver.Reference{
+		New:   func() solver.Solver { return &ReferenceSolver{} },
+		Parts: []int{2},
+	})
+}
+
+// ReferenceSolver counts the combinations over the whole chain, without splitting it by the gaps of 3 jolts
+type ReferenceSolver struct {
+	Solver
+}
+
+func (s *ReferenceSolver) PartTwo() (string, error) {
+	return strconv.FormatInt(s.adapterChain.countChains(), 10), nil
+}
+
+// countChains counts the chains ending in each adapter, which is the sum of the chains ending in the adapters
+// at most 3 jolts lower
+func (a AdapterChain) countChains() int64 {
+	chains := make([]int64, len(a))
+	chains[0] = 1 // the charging socket
+	for i := 1; i < len(a); i++ {
+		for j := i - 1; j >= 0 && a[i]-a[j] <= 3; j-- {
+			chains[i] += chains[j]
+		}
+	}
+
+	return chains[len(a)-1]
+}
@@ -0,0 +1,203 @@
+package day11
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const maxIterations = 1000 // just to be sure we stop somewhere
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 11, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	solver.FrameRecorder
+	solver.LoggerHolder
+	lines []string
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if _, err = NewSeatPlan(lines); err != nil {
+		return err
+	}
+	s.lines = lines // seat plan is modified by the iterations, each part starts with a new one
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	seatPlan, _ := NewSeatPlan(s.lines)
+	seatPlan.onIteration = s.getFrameRecorder(1)
+	seatPlan.logger = s.Logger().With("part", 1)
+	if _, err := seatPlan.iterateUntilStable(s.Context(), seatPlan.iterationTransformerV1, maxIterations); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(seatPlan.countOccupiedSeats()), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	seatPlan, _ := NewSeatPlan(s.lines)
+	seatPlan.onIteration = s.getFrameRecorder(2)
+	seatPlan.logger = s.Logger().With("part", 2)
+	if _, err := seatPlan.iterateUntilStable(s.Context(), seatPlan.iterationTransformerV2, maxIterations); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(seatPlan.countOccupiedSeats()), nil
+}
+
+func (s *Solver) getFrameRecorder(part int) func(plan *grid.Grid[byte]) {
+	if !s.Animating() {
+		return nil
+	}
+	return func(plan *grid.Grid[byte]) {
+		s.RecordFrame(part, plan)
+	}
+}
+
+const occupiedSeat = '#'
+const emptySeat = 'L'
+const floor = '.'
+
+type SeatPlan struct {
+	plan        *grid.Grid[byte]
+	onIteration func(plan *grid.Grid[byte]) // called with the initial plan and after every iteration (optional)
+	logger      *slog.Logger
+}
+
+type SeatTransformer func(p grid.Point) byte
+
+func NewSeatPlan(lines []string) (*SeatPlan, error) {
+	plan, err := grid.ParseBytes(lines, string([]byte{occupiedSeat, emptySeat, floor}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeatPlan{plan: plan, logger: slog.Default()}, nil
+}
+
+func (sp *SeatPlan) iterateUntilStable(ctx context.Context, transformFunc SeatTransformer, maxIterations int) (int, error) {
+	changed := true
+	counter := 0
+	if sp.onIteration != nil {
+		sp.onIteration(sp.plan)
+	}
+	for changed {
+		if counter > maxIterations {
+			return counter, fmt.Errorf("max number of iterations [%d] reached and seat plan is still changing", maxIterations)
+		}
+		if err := solver.Interrupted(ctx, "iteration %d", counter+1); err != nil {
+			return counter, err
+		}
+		changed = sp.runIteration(transformFunc)
+		counter++
+		if sp.onIteration != nil {
+			sp.onIteration(sp.plan)
+		}
+		if sp.logger.Enabled(ctx, slog.LevelDebug) {
+			sp.logger.Debug("seat plan iteration", "iteration", counter, "changed", changed, "occupied", sp.countOccupiedSeats())
+		}
+		if sp.logger.Enabled(ctx, solver.LevelTrace) {
+			sp.logger.Log(ctx, solver.LevelTrace, "seat plan", "iteration", counter, "plan", sp.render())
+		}
+	}
+
+	return counter, nil
+}
+
+func (sp *SeatPlan) runIteration(transformFunc SeatTransformer) bool {
+	planChanged := false
+	newPlan := sp.plan.Clone()
+	sp.plan.Each(func(p grid.Point, seat byte) {
+		newSeat := transformFunc(p)
+		newPlan.Set(p, newSeat)
+		planChanged = planChanged || seat != newSeat
+	})
+	sp.plan = newPlan
+
+	return planChanged
+}
+
+func (sp *SeatPlan) iterationTransformerV1(p grid.Point) byte {
+	seat := sp.plan.At(p)
+	if seat == emptySeat {
+		if sp.countOccupiedAdjacentSeats(p) == 0 {
+			return occupiedSeat // seat just became occupied
+		}
+	} else if seat == occupiedSeat {
+		if sp.countOccupiedAdjacentSeats(p) > 3 {
+			return emptySeat // seat just became empty
+		}
+	}
+
+	return seat // no change otherwise
+}
+
+func (sp *SeatPlan) iterationTransformerV2(p grid.Point) byte {
+	seat := sp.plan.At(p)
+	if seat == emptySeat {
+		if sp.countOccupiedVisibleSeats(p) == 0 {
+			return occupiedSeat // seat just became occupied
+		}
+	} else if seat == occupiedSeat {
+		if sp.countOccupiedVisibleSeats(p) > 4 {
+			return emptySeat // seat just became empty
+		}
+	}
+
+	return seat // no change otherwise
+}
+
+func (sp *SeatPlan) countOccupiedAdjacentSeats(p grid.Point) int {
+	return sp.plan.CountNeighbors(p, grid.Adjacent, isOccupied)
+}
+
+func (sp *SeatPlan) countOccupiedVisibleSeats(p grid.Point) int {
+	counter := 0
+	for _, direction := range grid.Adjacent {
+		// the first seat in sight, floor all the way to the edge means no seat
+		if _, seat, ok := sp.plan.Cast(p, direction, isSeat); ok && seat == occupiedSeat {
+			counter++
+		}
+	}
+
+	return counter
+}
+
+func (sp *SeatPlan) countOccupiedSeats() int {
+	return sp.plan.Count(isOccupied)
+}
+
+func (sp *SeatPlan) render() string {
+	return sp.plan.Render(func(seat byte) byte { return seat })
+}
+
+func isOccupied(seat byte) bool {
+	return seat == occupiedSeat
+}
+
+func isSeat(cell byte) bool {
+	return cell != floor
+}
@@ -0,0 +1,87 @@
+package day11
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestIterateUntilStable(t *testing.T) {
+	lines := testutils.ExampleLines(t, examples, "example")
+
+	tests := []struct {
+		name           string
+		getTransformer func(sp *SeatPlan) SeatTransformer
+		want           int
+	}{
+		{"adjacent seats", func(sp *SeatPlan) SeatTransformer { return sp.iterationTransformerV1 }, 37},
+		{"visible seats", func(sp *SeatPlan) SeatTransformer { return sp.iterationTransformerV2 }, 26},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seatPlan, err := NewSeatPlan(lines)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = seatPlan.iterateUntilStable(context.Background(), tt.getTransformer(seatPlan), maxIterations); err != nil {
+				t.Fatal(err)
+			}
+			if got := seatPlan.countOccupiedSeats(); got != tt.want {
+				t.Errorf("occupied seats = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountOccupiedVisibleSeats(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		i, j  int
+		want  int
+	}{
+		{"all eight directions", []string{
+			".......#.",
+			"...#.....",
+			".#.......",
+			".........",
+			"..#L....#",
+			"....#....",
+			".........",
+			"#........",
+			"...#.....",
+		}, 4, 3, 8},
+		{"empty seat blocks the view", []string{
+			".............",
+			".L.L.#.#.#.#.",
+			".............",
+		}, 1, 1, 0},
+		{"no occupied seat in sight", []string{
+			".##.##.",
+			"#.#.#.#",
+			"##...##",
+			"...L...",
+			"##...##",
+			"#.#.#.#",
+			".##.##.",
+		}, 3, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seatPlan, err := NewSeatPlan(tt.lines)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := seatPlan.countOccupiedVisibleSeats(grid.Point{Row: tt.i, Column: tt.j}); got != tt.want {
+				t.Errorf("countOccupiedVisibleSeats(%d, %d) = %d, want %d", tt.i, tt.j, got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
@@ -0,0 +1,57 @@
+package day11
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 11, solver.Generator{
+		Defaults: solver.Params{"width": 90, "height": 95, "density": 70},
+		Generate: generate,
+	})
+}
+
+const maxAttempts = 10
+
+// generate creates an empty seat plan, density is the percentage of seats (the rest is floor); dense random plans often
+// contain regions which keep filling and emptying forever, so the plan is only accepted if both parts get stable
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("width", 1, 1000); err != nil {
+		return "", err
+	}
+	if err := params.Check("height", 1, 1000); err != nil {
+		return "", err
+	}
+	if err := params.Check("density", 0, 100); err != nil {
+		return "", err
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		lines := gen.Pattern(r, params["width"], params["height"], float64(params["density"])/100, emptySeat, floor)
+		if isStable(lines) {
+			return gen.Lines(lines), nil
+		}
+	}
+	return "", fmt.Errorf("no seat plan getting stable found in %d attempts, try a lower density", maxAttempts)
+}
+
+func isStable(lines []string) bool {
+	for _, transformer := range []func(sp *SeatPlan) SeatTransformer{
+		func(sp *SeatPlan) SeatTransformer { return sp.iterationTransformerV1 },
+		func(sp *SeatPlan) SeatTransformer { return sp.iterationTransformerV2 },
+	} {
+		seatPlan, err := NewSeatPlan(lines)
+		if err != nil {
+			return false
+		}
+		if _, err = seatPlan.iterateUntilStable(context.Background(), transformer(seatPlan), maxIterations); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -1,8 +1,14 @@
-package main
+package day12
 
 import (
+	"embed"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
 const North = 0
@@ -10,18 +16,41 @@ const East = 90
 const South = 180
 const West = 270
 
-func main() {
-	instructionSet := NewNavInstructionSet()
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 12, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	instructionSet NavInstructionSet
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.instructionSet, err = NewNavInstructionSet(lines)
+	return err
+}
 
-	// Part I.
+func (s *Solver) PartOne() (string, error) {
 	ship := NewDefaultShip()
-	ship.move(instructionSet, 1)
-	fmt.Printf("V1 final ship position [N: %d, W: %d], manhattan distance: %d\n", ship.position.north, ship.position.west, ship.position.getManhattanDistance())
+	ship.move(s.instructionSet, 1)
+	return strconv.Itoa(ship.position.getManhattanDistance()), nil
+}
 
-	// Part II.
-	ship = NewDefaultShip()
-	ship.move(instructionSet, 2)
-	fmt.Printf("V2 final ship position [N: %d, W: %d], manhattan distance: %d\n", ship.position.north, ship.position.west, ship.position.getManhattanDistance())
+func (s *Solver) PartTwo() (string, error) {
+	ship := NewDefaultShip()
+	ship.move(s.instructionSet, 2)
+	return strconv.Itoa(ship.position.getManhattanDistance()), nil
 }
 
 type Position struct {
@@ -40,7 +69,7 @@ func (sp Position) move(direction int, distance int) Position {
 	case West:
 		return Position{sp.north, sp.west + distance}
 	default:
-		panic(fmt.Errorf("unsupported move direction value [%d]", direction))
+		panic(fmt.Errorf("unsupported move direction value [%d]", direction)) // unreachable, NewNavInstruction allows only the turns by multiples of 90
 	}
 }
 
@@ -62,12 +91,12 @@ func (sp Position) rotateClockwise(degrees int) Position {
 	case 270:
 		return Position{-sp.west, sp.north}
 	default:
-		panic(fmt.Errorf("unsupported rotation degrees value [%d]", degrees))
+		panic(fmt.Errorf("unsupported rotation degrees value [%d]", degrees)) // unreachable, NewNavInstruction normalizes the turns to 0-270 by 90
 	}
 }
 
 func (sp Position) getManhattanDistance() int {
-	return AbsInt(sp.north) + AbsInt(sp.west)
+	return utils.AbsInt(sp.north) + utils.AbsInt(sp.west)
 }
 
 type Ship struct {
@@ -95,11 +124,14 @@ type NavInstructionSet struct {
 	transform    []map[string]NavInstructionTransformer
 }
 
-func NewNavInstructionSet() NavInstructionSet {
-	lines := ReadLines("inputs/day_12.txt")
+func NewNavInstructionSet(lines []string) (NavInstructionSet, error) {
 	instructions := make([]*NavInstruction, len(lines))
 	for i, line := range lines {
-		instructions[i] = NewNavInstruction(line)
+		instruction, err := NewNavInstruction(line)
+		if err != nil {
+			return NavInstructionSet{}, utils.AtLine(err, i+1, line)
+		}
+		instructions[i] = instruction
 	}
 
 	return NavInstructionSet{
@@ -108,7 +140,7 @@ func NewNavInstructionSet() NavInstructionSet {
 			getInstructionTransformersV1(),
 			getInstructionTransformersV2(),
 		},
-	}
+	}, nil
 }
 
 type NavInstruction struct {
@@ -116,21 +148,28 @@ type NavInstruction struct {
 	value  int
 }
 
-func NewNavInstruction(line string) *NavInstruction {
+func NewNavInstruction(line string) (*NavInstruction, error) {
+	if len(line) < 2 {
+		return nil, utils.NewParseError(1, line, fmt.Errorf("instruction must be an action followed by a number"))
+	}
+	if !strings.Contains("NSEWLRF", line[0:1]) {
+		return nil, utils.NewParseError(1, line[0:1], fmt.Errorf("unknown action"))
+	}
 	value, err := strconv.Atoi(line[1:])
-	if err != nil {
-		panic(fmt.Errorf("invalid instruction [%s], argument not numeric", line))
+	if err != nil || value < 0 {
+		return nil, utils.NewParseError(2, line[1:], fmt.Errorf("argument not a positive number"))
 	}
 	if line[0] == 'L' || line[0] == 'R' {
 		if value%90 != 0 {
 			// this would mean trigonometry hell, let's say it's not allowed
-			panic(fmt.Errorf("invalid instruction [%s], cannot turn uneven angles", line))
+			return nil, utils.NewParseError(2, line[1:], fmt.Errorf("cannot turn uneven angles"))
 		}
+		value %= 360 // normalize rotation to 0-360
 	}
 	return &NavInstruction{
 		action: line[0:1],
-		value:  value % 360, // normalize rotation to 0-360
-	}
+		value:  value,
+	}, nil
 }
 
 type NavInstructionTransformer func(Position, Position, int, int) (Position, Position, int)
@@ -176,7 +215,7 @@ func getInstructionTransformersV2() map[string]NavInstructionTransformer {
 			return p, w.move(West, instructionValue), d
 		},
 		"L": func(p Position, w Position, d int, instructionValue int) (Position, Position, int) {
-			return p, w.rotateClockwise(360 - instructionValue), d
+			return p, w.rotateClockwise((360 - instructionValue) % 360), d
 		},
 		"R": func(p Position, w Position, d int, instructionValue int) (Position, Position, int) {
 			return p, w.rotateClockwise(instructionValue), d
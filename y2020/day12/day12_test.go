@@ -0,0 +1,115 @@
+package day12
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestShipMove(t *testing.T) {
+	instructionSet, err := NewNavInstructionSet(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		version      int
+		wantPosition Position
+		wantDistance int
+	}{
+		{1, Position{north: -8, west: -17}, 25},
+		{2, Position{north: -72, west: -214}, 286},
+	}
+	for _, tt := range tests {
+		ship := NewDefaultShip()
+		ship.move(instructionSet, tt.version)
+		if ship.position != tt.wantPosition {
+			t.Errorf("v%d: position = %+v, want %+v", tt.version, ship.position, tt.wantPosition)
+		}
+		if got := ship.position.getManhattanDistance(); got != tt.wantDistance {
+			t.Errorf("v%d: distance = %d, want %d", tt.version, got, tt.wantDistance)
+		}
+	}
+}
+
+func TestNewNavInstruction(t *testing.T) {
+	tests := []struct {
+		line      string
+		wantValue int
+	}{
+		{"F10", 10},
+		{"F720", 720}, // moves are not normalized
+		{"N400", 400},
+		{"R90", 90},
+		{"L450", 90}, // turns are
+		{"R360", 0},
+		{"L360", 0},
+	}
+	for _, tt := range tests {
+		instruction, err := NewNavInstruction(tt.line)
+		if err != nil {
+			t.Errorf("NewNavInstruction(%q) error: %s", tt.line, err)
+			continue
+		}
+		if instruction.value != tt.wantValue {
+			t.Errorf("NewNavInstruction(%q).value = %d, want %d", tt.line, instruction.value, tt.wantValue)
+		}
+	}
+}
+
+func TestShipMoveOver360(t *testing.T) {
+	instructionSet, err := NewNavInstructionSet([]string{"F720", "L450", "F10", "L360"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		version      int
+		wantPosition Position
+	}{
+		{1, Position{north: 10, west: -720}},
+		{2, Position{north: 820, west: -7190}},
+	}
+	for _, tt := range tests {
+		ship := NewDefaultShip()
+		ship.move(instructionSet, tt.version)
+		if ship.position != tt.wantPosition {
+			t.Errorf("v%d: position = %+v, want %+v", tt.version, ship.position, tt.wantPosition)
+		}
+	}
+}
+
+func TestRotateClockwise(t *testing.T) {
+	waypoint := Position{north: 4, west: -10} // 10 east, 4 north
+	tests := []struct {
+		degrees int
+		want    Position
+	}{
+		{0, Position{north: 4, west: -10}},
+		{90, Position{north: -10, west: -4}},
+		{180, Position{north: -4, west: 10}},
+		{270, Position{north: 10, west: 4}},
+	}
+	for _, tt := range tests {
+		if got := waypoint.rotateClockwise(tt.degrees); got != tt.want {
+			t.Errorf("rotateClockwise(%d) = %+v, want %+v", tt.degrees, got, tt.want)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewNavInstruction(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		instructions, err := NewNavInstructionSet([]string{line})
+		if err != nil {
+			return
+		}
+		for version := 1; version <= 2; version++ {
+			ship := NewDefaultShip()
+			ship.move(instructions, version)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package day12
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 12, solver.Generator{
+		Defaults: solver.Params{"count": 780},
+		Generate: generate,
+	})
+}
+
+// generate creates random navigation instructions, the turns are always by a multiple of 90 degrees
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 100000); err != nil {
+		return "", err
+	}
+	lines := make([]string, params["count"])
+	for i := range lines {
+		action := "NSEWLRFF"[r.Intn(8)] // forward moves are more frequent
+		value := 1 + r.Intn(100)
+		if action == 'L' || action == 'R' {
+			value = 90 * (1 + r.Intn(3))
+		}
+		lines[i] = fmt.Sprintf("%c%d", action, value)
+	}
+
+	return gen.Lines(lines), nil
+}
@@ -0,0 +1,132 @@
+package day13
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/numtheory"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 13, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	arrivalTime int64
+	schedule    BusSchedule
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.arrivalTime, s.schedule, err = readBusNotes(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	busPeriod, waitTime := s.schedule.getBestWaitTime(s.arrivalTime)
+	return strconv.FormatInt(busPeriod*waitTime, 10), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	solution, err := s.schedule.getSolution()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(solution, 10), nil
+}
+
+func readBusNotes(input []string) (int64, BusSchedule, error) {
+	if len(input) < 2 {
+		return 0, nil, fmt.Errorf("bus notes must have 2 lines (arrival time and bus schedule)")
+	}
+	arrivalTime, err := strconv.ParseInt(input[0], 10, 64)
+	if err != nil {
+		return 0, nil, &utils.ParseError{Line: 1, Column: 1, Text: input[0], Err: fmt.Errorf("invalid arrival time")}
+	}
+	schedule, err := NewBusSchedule(input[1])
+	if err != nil {
+		return 0, nil, utils.AtLine(err, 2, input[1])
+	}
+
+	return arrivalTime, schedule, nil
+}
+
+type BusSchedule []int64
+
+func NewBusSchedule(inputLine string) (BusSchedule, error) {
+	scheduleItems := strings.Split(inputLine, ",")
+	schedule := make([]int64, len(scheduleItems))
+	column := 1
+	busCount := 0
+	for i := range scheduleItems {
+		period := int64(0)
+		if scheduleItems[i] != "x" {
+			var err error
+			period, err = strconv.ParseInt(scheduleItems[i], 10, 64)
+			if err != nil || period < 1 {
+				return nil, utils.NewParseError(column, scheduleItems[i], fmt.Errorf("invalid bus number"))
+			}
+			busCount++
+		}
+		schedule[i] = period
+		column += len(scheduleItems[i]) + 1
+	}
+	if busCount == 0 {
+		return nil, utils.NewParseError(1, inputLine, fmt.Errorf("no bus in service"))
+	}
+	return schedule, nil
+}
+
+func (bs BusSchedule) getBestWaitTime(arrivalTime int64) (int64, int64) {
+	bestBusPeriod := int64(0)
+	bestWaitTime := int64(math.MaxInt64)
+	for _, busPeriod := range bs {
+		if busPeriod == 0 {
+			continue
+		}
+		waitTime := busPeriod - arrivalTime%busPeriod
+		if waitTime < bestWaitTime {
+			bestBusPeriod = busPeriod
+			bestWaitTime = waitTime
+		}
+	}
+
+	return bestBusPeriod, bestWaitTime
+}
+
+// getSolution finds the earliest time when each bus departs the number of minutes after the time given by its index
+func (bs BusSchedule) getSolution() (int64, error) {
+	// time + index = 0 (mod period) for every bus, which is a system of congruences solvable by CRT
+	residues := make([]int64, 0, len(bs))
+	moduli := make([]int64, 0, len(bs))
+	for index, period := range bs {
+		if period == 0 {
+			continue
+		}
+		residues = append(residues, -int64(index))
+		moduli = append(moduli, period)
+	}
+	solution, _, err := numtheory.CRT(residues, moduli)
+	if err != nil {
+		return 0, fmt.Errorf("no time matches the schedule: %w", err)
+	}
+
+	return solution, nil
+}
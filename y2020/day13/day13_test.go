@@ -0,0 +1,84 @@
+package day13
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetBestWaitTime(t *testing.T) {
+	arrivalTime, schedule, err := readBusNotes(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	busPeriod, waitTime := schedule.getBestWaitTime(arrivalTime)
+	if busPeriod != 59 || waitTime != 5 {
+		t.Errorf("getBestWaitTime() = (%d, %d), want (59, 5)", busPeriod, waitTime)
+	}
+}
+
+func TestGetSolution(t *testing.T) {
+	tests := []struct {
+		schedule string
+		want     int64
+	}{
+		{"7,13,x,x,59,x,31,19", 1068781},
+		{"17,x,13,19", 3417},
+		{"67,7,59,61", 754018},
+		{"67,x,7,59,61", 779210},
+		{"67,7,x,59,61", 1261476},
+		{"1789,37,47,1889", 1202161486},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			schedule, err := NewBusSchedule(tt.schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := schedule.getSolution()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("getSolution() = %d, want %d", got, tt.want)
+			}
+			if got, err = schedule.searchSolution(context.Background()); err != nil || got != tt.want {
+				t.Errorf("searchSolution() = (%d, %v), want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSolutionNone(t *testing.T) {
+	for _, line := range []string{"2,4", "4,6", "x,6,4", "6,10,x,15"} {
+		t.Run(line, func(t *testing.T) {
+			schedule, err := NewBusSchedule(line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := schedule.getSolution(); err == nil {
+				t.Errorf("getSolution() = %d, want an error", got)
+			}
+			if got, err := schedule.searchSolution(context.Background()); err == nil {
+				t.Errorf("searchSolution() = %d, want an error", got)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewBusSchedule(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		schedule, err := NewBusSchedule(line)
+		if err != nil {
+			return
+		}
+		schedule.getBestWaitTime(939)
+		_, _ = schedule.getSolution()
+	})
+}
@@ -0,0 +1,106 @@
+package day13
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 13, solver.Generator{
+		Defaults: solver.Params{"buses": 9, "length": 70},
+		Generate: generate,
+	})
+}
+
+const maxPeriodProduct = 1e18 // keeps the part two timestamp within int64
+
+// generate creates a schedule of buses with distinct prime periods, two of them large like in the real input
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("buses", 1, 9); err != nil {
+		return "", err
+	}
+	if err := params.Check("length", params["buses"], 1000); err != nil {
+		return "", err
+	}
+	small, large := getPrimes(11, 60), getPrimes(400, 1000)
+	periods := make([]int, 0, params["buses"])
+	product := 1.0
+	for len(periods) < params["buses"] {
+		candidates := small
+		if len(periods) < 2 {
+			candidates = large
+		}
+		candidates = gen.Subset(r, candidates, 1) // copy
+		r.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+		found := false
+		for _, period := range candidates {
+			if product*float64(period) <= maxPeriodProduct && !containsInt(periods, period) {
+				periods = append(periods, period)
+				product *= float64(period)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("too many buses, the product of their periods would overflow")
+		}
+	}
+
+	schedule := make([]string, params["length"])
+	for i := range schedule {
+		schedule[i] = "x"
+	}
+	positions := append([]int{0}, gen.Shuffled(r, makeRange(1, len(schedule)))[:len(periods)-1]...)
+	for i, position := range positions {
+		schedule[position] = fmt.Sprintf("%d", periods[i])
+	}
+	schedule = schedule[:maxInt(positions)+1] // the schedule ends with a bus
+
+	return gen.Lines([]string{fmt.Sprintf("%d", 100000+r.Intn(1000000)), strings.Join(schedule, ",")}), nil
+}
+
+func getPrimes(from int, to int) []int {
+	result := make([]int, 0)
+	for n := from; n < to; n++ {
+		prime := n > 1
+		for d := 2; d*d <= n; d++ {
+			if n%d == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func maxInt(values []int) int {
+	result := values[0]
+	for _, v := range values {
+		result = max(result, v)
+	}
+	return result
+}
+
+func makeRange(from int, to int) []int {
+	result := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		result = append(result, i)
+	}
+	return result
+}
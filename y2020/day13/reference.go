@@ -0,0 +1,85 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/numtheory"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterReference(2020, 13, solver.Reference{
+		New:    func() solver.Solver { return &ReferenceSolver{} },
+		Parts:  []int{2},
+		Params: solver.Params{"buses": 4}, // the search takes as many steps as the product of all the periods but the largest
+	})
+}
+
+// ReferenceSolver searches the part two time step by step instead of solving the congruences
+type ReferenceSolver struct {
+	solver.ContextHolder
+	Solver
+}
+
+func (s *ReferenceSolver) PartTwo() (string, error) {
+	solution, err := s.schedule.searchSolution(s.Context())
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(solution, 10), nil
+}
+
+// searchSolution tries the times when the bus with the longest period departs on time until all the other buses do too,
+// the departures repeat after the LCM of the periods so there is no solution if none is found before it
+func (bs BusSchedule) searchSolution(ctx context.Context) (int64, error) {
+	maxPeriod, maxPeriodIndex := int64(0), 0
+	for index, period := range bs {
+		if period > maxPeriod {
+			maxPeriod, maxPeriodIndex = period, index
+		}
+	}
+
+	limit := bs.repeatPeriod()
+	time := ((-int64(maxPeriodIndex))%maxPeriod + maxPeriod) % maxPeriod // the first time the longest bus departs on time
+	for step := 0; !bs.isSolution(time); step++ {
+		if time >= limit-maxPeriod {
+			return 0, fmt.Errorf("no time matches the schedule (none before %d)", limit)
+		}
+		if step%solver.CheckInterval == 0 {
+			if err := solver.Interrupted(ctx, "time %d", time); err != nil {
+				return 0, err
+			}
+		}
+		time += maxPeriod
+	}
+
+	return time, nil
+}
+
+// repeatPeriod is the LCM of all the bus periods (capped at the largest int64), the departures repeat after it
+func (bs BusSchedule) repeatPeriod() int64 {
+	result := int64(1)
+	for _, period := range bs {
+		if period == 0 {
+			continue
+		}
+		factor := period / numtheory.GCD(result, period)
+		if result > math.MaxInt64/factor {
+			return math.MaxInt64
+		}
+		result *= factor
+	}
+	return result
+}
+
+func (bs BusSchedule) isSolution(time int64) bool {
+	for index, period := range bs {
+		if period != 0 && (time+int64(index))%period != 0 {
+			return false // should depart but does not
+		}
+	}
+	return true
+}
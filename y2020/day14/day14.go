@@ -0,0 +1,259 @@
+package day14
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 14, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	program []WriteInstruction
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.program, err = readInitProgram(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	memoryV1, err := runInitProgramV1(s.Context(), s.program)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(memoryV1.sum(), 10), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	memoryV2, err := runInitProgramV2(s.Context(), s.program)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(memoryV2.sum(), 10), nil
+}
+
+// WriteInstruction is a memory write together with the mask valid at the time of the write
+type WriteInstruction struct {
+	mask  BitMask
+	index int64
+	value int64
+}
+
+func readInitProgram(lines []string) ([]WriteInstruction, error) {
+	program := make([]WriteInstruction, 0, len(lines))
+	currentMask := BitMask("")
+	for i, line := range lines {
+		if isBitMask(line) {
+			mask, err := NewBitMask(line) // just set the mask
+			if err != nil {
+				return nil, utils.AtLine(err, i+1, line)
+			}
+			currentMask = mask
+			continue
+		}
+		index, value, err := parseWriteInstruction(line)
+		if err != nil {
+			return nil, utils.AtLine(err, i+1, line)
+		}
+		if currentMask == "" {
+			return nil, &utils.ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("mask not initialized before the first [write] operation")}
+		}
+		program = append(program, WriteInstruction{
+			mask:  currentMask,
+			index: index,
+			value: value,
+		})
+	}
+
+	return program, nil
+}
+
+func runInitProgramV1(ctx context.Context, program []WriteInstruction) (Memory, error) {
+	memory := make(Memory)
+	for i, instruction := range program {
+		if i%solver.CheckInterval == 0 {
+			if err := solver.Interrupted(ctx, "instruction %d of %d", i+1, len(program)); err != nil {
+				return nil, err
+			}
+		}
+		memory.add(instruction.index, instruction.mask.applyToMemoryValue(instruction.value))
+	}
+
+	return memory, nil
+}
+
+// maxWritesV2 limits the writes of the V2 decoder, every one of them can add a memory address (the real inputs have
+// about 100 thousand of them, a single mask with 36 floating bits would write to 2^36 addresses)
+const maxWritesV2 = 1 << 20
+
+// V2 decoder writes to all the floating addresses, it is kept separate from V1 as masks with many floating bits explode here
+// (the programs with more than maxWritesV2 writes are rejected, the writes stop when the context is cancelled)
+func runInitProgramV2(ctx context.Context, program []WriteInstruction) (Memory, error) {
+	total := int64(0)
+	for _, instruction := range program {
+		total += 1 << instruction.mask.countFloatingBits()
+		if total > maxWritesV2 {
+			return nil, fmt.Errorf("too many floating bits, the program writes to more than %d addresses", maxWritesV2)
+		}
+	}
+
+	memory := make(Memory, total)
+	writes := 0
+	for i, instruction := range program {
+		err := instruction.mask.applyToMemoryIndex(instruction.index, func(indexWithMask int64) error {
+			if writes%solver.CheckInterval == 0 {
+				if err := solver.Interrupted(ctx, "instruction %d of %d", i+1, len(program)); err != nil {
+					return err
+				}
+			}
+			writes++
+			memory.add(indexWithMask, instruction.value)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return memory, nil
+}
+
+type Memory map[int64]int64
+
+func (m Memory) add(index int64, value int64) {
+	m[index] = value
+}
+
+func (m Memory) sum() int64 {
+	sum := int64(0)
+	for _, val := range m {
+		sum += val
+	}
+	return sum
+}
+
+type BitMask string
+
+const maskPrefix = "mask = "
+const maskSize = 36
+
+func isBitMask(inputLine string) bool {
+	return strings.HasPrefix(inputLine, maskPrefix)
+}
+
+func NewBitMask(inputLine string) (BitMask, error) {
+	if !isBitMask(inputLine) {
+		return "", utils.NewParseError(1, inputLine, fmt.Errorf("mask must start with [%s]", maskPrefix))
+	}
+	mask := inputLine[len(maskPrefix):]
+	if len(mask) != maskSize {
+		return "", utils.NewParseError(len(maskPrefix)+1, mask, fmt.Errorf("mask must have exactly %d bits", maskSize))
+	}
+	if i := strings.IndexFunc(mask, func(r rune) bool { return r != '0' && r != '1' && r != 'X' }); i >= 0 {
+		return "", utils.NewParseError(len(maskPrefix)+i+1, mask[i:i+1], fmt.Errorf("invalid mask bit, expected one of [01X]"))
+	}
+
+	return BitMask(mask), nil
+}
+
+func (m BitMask) countFloatingBits() int {
+	return strings.Count(string(m), "X")
+}
+
+func (m BitMask) getBitNumber(bitIndex int) int {
+	return len(m) - 1 - bitIndex // bits are in reverse order (0-th bit is last in mask)
+}
+
+func (m BitMask) applyToMemoryValue(value int64) int64 {
+	result := value
+	for i := range m {
+		if m[i] == 'X' {
+			continue // no change
+		}
+		result = setBitToValue(result, m.getBitNumber(i), m[i] == '1') // force the value of this bit
+	}
+	return result
+}
+
+// applyToMemoryIndex calls the function with every memory index given by the floating bits until it returns an error
+// (the indexes are not collected, there are too many of them for the masks with many floating bits)
+func (m BitMask) applyToMemoryIndex(index int64, f func(int64) error) error {
+	// first get the modified memory index without floating bits
+	baseMemoryIndex := index
+	floatingBitNumbers := make([]int, 0)
+	for i := range m {
+		if m[i] == '1' {
+			baseMemoryIndex = setBitToValue(baseMemoryIndex, m.getBitNumber(i), true)
+		} else if m[i] == 'X' {
+			floatingBitNumbers = append(floatingBitNumbers, m.getBitNumber(i))
+		}
+	}
+
+	// each combination of the floating bit values is a number, its i-th bit is the value of the i-th floating bit
+	for combination := int64(0); combination < 1<<len(floatingBitNumbers); combination++ {
+		result := baseMemoryIndex
+		for i, floatingBitNumber := range floatingBitNumbers {
+			result = setBitToValue(result, floatingBitNumber, getBitFromValue(combination, i))
+		}
+		if err := f(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseWriteInstruction(inputLine string) (int64, int64, error) {
+	pattern := regexp.MustCompile("^mem\\[([0-9]+)] = ([0-9]+)$")
+	matches := pattern.FindStringSubmatchIndex(inputLine)
+	if matches == nil {
+		return 0, 0, utils.NewParseError(1, inputLine, fmt.Errorf("not a valid memory write instruction"))
+	}
+
+	index, err := strconv.ParseInt(inputLine[matches[2]:matches[3]], 10, 64)
+	if err != nil || index >= 1<<maskSize {
+		return 0, 0, utils.NewParseError(matches[2]+1, inputLine[matches[2]:matches[3]], fmt.Errorf("memory index out of range"))
+	}
+
+	value, err := strconv.ParseInt(inputLine[matches[4]:matches[5]], 10, 64)
+	if err != nil || value >= 1<<maskSize {
+		return 0, 0, utils.NewParseError(matches[4]+1, inputLine[matches[4]:matches[5]], fmt.Errorf("memory value out of range"))
+	}
+
+	return index, value, nil
+}
+
+func getBitFromValue(value int64, bitNumber int) bool {
+	return (value&(1<<bitNumber))>>bitNumber == 1
+}
+
+func setBitToValue(value int64, bitNumber int, bitValue bool) int64 {
+	if bitValue {
+		return value | (1 << bitNumber)
+	} else {
+		return value & ^(1 << bitNumber)
+	}
+}
@@ -0,0 +1,118 @@
+package day14
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestRunInitProgram(t *testing.T) {
+	tests := []struct {
+		example string
+		version int
+		want    int64
+	}{
+		{"example", 1, 165},
+		{"example2", 2, 208}, // the first example has too many floating bits for the V2 decoder
+	}
+	for _, tt := range tests {
+		t.Run(tt.example, func(t *testing.T) {
+			program, err := readInitProgram(testutils.ExampleLines(t, examples, tt.example))
+			if err != nil {
+				t.Fatal(err)
+			}
+			run := runInitProgramV1
+			if tt.version == 2 {
+				run = runInitProgramV2
+			}
+			memory, err := run(context.Background(), program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := memory.sum(); got != tt.want {
+				t.Errorf("memory sum (v%d) = %d, want %d", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunInitProgramV2Cancelled(t *testing.T) {
+	program, err := readInitProgram([]string{"mask = " + strings.Repeat("0", maskSize-20) + strings.Repeat("X", 20), "mem[8] = 11"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = runInitProgramV2(ctx, program)
+	var interrupted *solver.InterruptedError
+	if !errors.As(err, &interrupted) || interrupted.Progress != "instruction 1 of 1" {
+		t.Errorf("runInitProgramV2() error = %v, want interrupted at instruction 1", err)
+	}
+}
+
+func TestRunInitProgramV2TooManyWrites(t *testing.T) {
+	for _, lines := range [][]string{
+		{"mask = " + strings.Repeat("X", maskSize), "mem[8] = 11"},
+		{"mask = " + strings.Repeat("0", maskSize-19) + strings.Repeat("X", 19), "mem[8] = 11", "mem[9] = 11", "mem[10] = 11"},
+	} {
+		program, err := readInitProgram(lines)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if memory, err := runInitProgramV2(context.Background(), program); err == nil {
+			t.Errorf("runInitProgramV2(%q) wrote %d addresses, want an error", lines, len(memory))
+		}
+	}
+}
+
+func TestApplyToMemoryValue(t *testing.T) {
+	mask, err := NewBitMask("mask = XXXXXXXXXXXXXXXXXXXXXXXXXXXXX1XXXX0X")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		value int64
+		want  int64
+	}{
+		{11, 73},
+		{101, 101},
+		{0, 64},
+	}
+	for _, tt := range tests {
+		if got := mask.applyToMemoryValue(tt.value); got != tt.want {
+			t.Errorf("applyToMemoryValue(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewBitMask(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		mask, err := NewBitMask(line)
+		if err != nil {
+			return
+		}
+		if value := mask.applyToMemoryValue(1<<maskSize - 1); value < 0 || value >= 1<<maskSize {
+			t.Errorf("applyToMemoryValue() = %d, want a %d-bit value", value, maskSize)
+		}
+	})
+}
+
+func FuzzParseWriteInstruction(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		index, value, err := parseWriteInstruction(line)
+		if err != nil {
+			return
+		}
+		if index < 0 || index >= 1<<maskSize || value < 0 || value >= 1<<maskSize {
+			t.Errorf("parseWriteInstruction(%q) = %d, %d, want %d-bit values", line, index, value, maskSize)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package day14
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 14, solver.Generator{
+		Defaults: solver.Params{"masks": 100, "writes": 8, "floating": 9},
+		Generate: generate,
+	})
+}
+
+// generate creates masks followed by 1 to the given number of writes, floating is the maximum number of X bits in a
+// mask (every write of part two updates 2^floating addresses, at most maxWritesV2 in total)
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("masks", 1, 10000); err != nil {
+		return "", err
+	}
+	if err := params.Check("writes", 1, 100); err != nil {
+		return "", err
+	}
+	if err := params.Check("floating", 0, 16); err != nil {
+		return "", err
+	}
+	lines := make([]string, 0)
+	for i := 0; i < params["masks"]; i++ {
+		mask := make([]byte, maskSize)
+		for j := range mask {
+			mask[j] = "01"[r.Intn(2)]
+		}
+		for _, j := range gen.Shuffled(r, makeRange(0, maskSize))[:r.Intn(params["floating"]+1)] {
+			mask[j] = 'X'
+		}
+		lines = append(lines, maskPrefix+string(mask))
+		for j := 0; j < 1+r.Intn(params["writes"]); j++ {
+			lines = append(lines, fmt.Sprintf("mem[%d] = %d", r.Intn(1<<16), r.Intn(1<<30)))
+		}
+	}
+
+	return gen.Lines(lines), nil
+}
+
+func makeRange(from int, to int) []int {
+	result := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		result = append(result, i)
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package day15
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 15, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	solver.ProgressReporter
+	startingNumbers []int
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.startingNumbers, err = readStartingNumbers(lines[0])
+	return utils.AtLine(err, 1, lines[0])
+}
+
+func (s *Solver) PartOne() (string, error) {
+	number, err := getNthNumber(s.Context(), 2020, s.startingNumbers, s.Progress())
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(number), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	number, err := getNthNumber(s.Context(), 30000000, s.startingNumbers, s.Progress())
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(number), nil
+}
+
+func readStartingNumbers(line string) ([]int, error) {
+	startingNumbers, err := utils.SplitToInts(line, ",")
+	if err != nil {
+		return nil, err
+	}
+	items := strings.Split(line, ",")
+	column := 1
+	seen := make(map[int]struct{}, len(startingNumbers))
+	for i, number := range startingNumbers {
+		if _, ok := seen[number]; ok || number < 0 {
+			return nil, utils.NewParseError(column, items[i], fmt.Errorf("starting numbers must be unique and non-negative"))
+		}
+		seen[number] = struct{}{}
+		column += len(items[i]) + 1
+	}
+
+	return startingNumbers, nil
+}
+
+// getNthNumber plays the game until the n-th turn, the turns are reported to the progress function every check interval
+func getNthNumber(ctx context.Context, n int, startingNumbers []int, progress solver.ProgressFunc) (int, error) {
+	history := make(NumberHistory)
+	spokenNumber := 0
+	for i := 0; i < n-1; i++ {
+		if i%solver.CheckInterval == 0 {
+			if err := solver.Interrupted(ctx, "turn %d of %d", i+1, n); err != nil {
+				return 0, err
+			}
+			progress.Report(i, n)
+		}
+		if i < len(startingNumbers) {
+			spokenNumber = startingNumbers[i] // start by feeding the starting numbers into the history
+		}
+		spokenNumber = history.add(spokenNumber, i) // add the current number (and get the next one)
+	}
+	progress.Report(n, n)
+
+	return spokenNumber, nil
+}
+
+type NumberHistory map[int]int
+
+func (m NumberHistory) add(number int, iteration int) int {
+	lastOccurrence, exists := m[number]
+	if exists {
+		m[number] = iteration
+		return iteration - lastOccurrence
+	}
+
+	m[number] = iteration
+	return 0
+}
@@ -0,0 +1,92 @@
+package day15
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetNthNumber(t *testing.T) {
+	tests := []struct {
+		startingNumbers string
+		n               int
+		want            int
+	}{
+		{"0,3,6", 4, 0},
+		{"0,3,6", 10, 0},
+		{"0,3,6", 2020, 436},
+		{"1,3,2", 2020, 1},
+		{"2,1,3", 2020, 10},
+		{"1,2,3", 2020, 27},
+		{"2,3,1", 2020, 78},
+		{"3,2,1", 2020, 438},
+		{"3,1,2", 2020, 1836},
+		{"0,3,6", 30000000, 175594},
+	}
+	for _, tt := range tests {
+		t.Run(tt.startingNumbers, func(t *testing.T) {
+			if testing.Short() && tt.n > 2020 {
+				t.Skip("long game skipped in short mode")
+			}
+			startingNumbers, err := readStartingNumbers(tt.startingNumbers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := getNthNumber(context.Background(), tt.n, startingNumbers, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("getNthNumber(%d) = %d, want %d", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNthNumberCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := getNthNumber(ctx, 30000000, []int{0, 3, 6}, nil)
+	var interrupted *solver.InterruptedError
+	if !errors.As(err, &interrupted) || interrupted.Progress != "turn 1 of 30000000" {
+		t.Errorf("getNthNumber() error = %v, want interrupted at turn 1", err)
+	}
+}
+
+func TestGetNthNumberProgress(t *testing.T) {
+	n := 3*solver.CheckInterval + 2
+	var steps []int
+	_, err := getNthNumber(context.Background(), n, []int{0, 3, 6}, func(step int, total int) {
+		if total != n {
+			t.Errorf("progress total = %d, want %d", total, n)
+		}
+		steps = append(steps, step)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{0, solver.CheckInterval, 2 * solver.CheckInterval, 3 * solver.CheckInterval, n}
+	if !slices.Equal(steps, want) {
+		t.Errorf("progress steps = %v, want %v", steps, want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzReadStartingNumbers(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		numbers, err := readStartingNumbers(line)
+		if err != nil {
+			return
+		}
+		if len(numbers) == 0 {
+			t.Errorf("readStartingNumbers(%q) returned no numbers", line)
+		}
+	})
+}
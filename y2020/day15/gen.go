@@ -0,0 +1,34 @@
+package day15
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 15, solver.Generator{
+		Defaults: solver.Params{"count": 6, "max": 20},
+		Generate: generate,
+	})
+}
+
+// generate creates distinct starting numbers between 0 and max
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("max", 0, 1000000); err != nil {
+		return "", err
+	}
+	if err := params.Check("count", 1, params["max"]+1); err != nil {
+		return "", err
+	}
+	numbers := r.Perm(params["max"] + 1)[:params["count"]]
+	items := make([]string, len(numbers))
+	for i, number := range numbers {
+		items[i] = strconv.Itoa(number)
+	}
+
+	return gen.Lines([]string{strings.Join(items, ",")}), nil
+}
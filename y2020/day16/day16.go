@@ -0,0 +1,291 @@
+package day16
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 16, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	fields   TicketFields
+	myTicket Ticket
+	tickets  []Ticket
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.fields, s.myTicket, s.tickets, err = readTicketInput(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	scanningErrorRate := 0
+	for _, ticket := range s.tickets {
+		for _, value := range s.fields.getInvalidValues(ticket) {
+			scanningErrorRate += value
+		}
+	}
+
+	return strconv.Itoa(scanningErrorRate), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	validTickets := make([]Ticket, 0)
+	for _, ticket := range s.tickets {
+		if len(s.fields.getInvalidValues(ticket)) == 0 {
+			validTickets = append(validTickets, ticket)
+		}
+	}
+	positionMap, err := s.fields.getFieldPositions(validTickets)
+	if err != nil {
+		return "", err
+	}
+	result := 1
+	for _, field := range s.fields {
+		if field.isDepartureField() {
+			result *= s.myTicket[positionMap[field.name]]
+		}
+	}
+
+	return strconv.Itoa(result), nil
+}
+
+func readTicketInput(lines []string) (TicketFields, Ticket, []Ticket, error) {
+	blocks, err := records.SplitN(lines, 3)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	fieldBlock := blocks[0]
+	sections, err := records.Sections(blocks[1:], "your ticket:", "nearby tickets:")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	mySection, nearbySection := sections[0], sections[1]
+
+	validations := make(map[string]TicketField)
+	for i, line := range fieldBlock.Lines {
+		field, err := NewTicketField(line)
+		if err != nil {
+			return nil, nil, nil, fieldBlock.Locate(utils.AtLine(err, i+1, line))
+		}
+		if _, exists := validations[field.name]; exists {
+			return nil, nil, nil, fieldBlock.Locate(&utils.ParseError{Line: i + 1, Column: 1, Text: field.name, Err: fmt.Errorf("duplicate ticket field")})
+		}
+		validations[field.name] = field
+	}
+	if len(mySection.Lines) != 1 {
+		return nil, nil, nil, mySection.Locate(fmt.Errorf("exactly one ticket expected in section [%s]", mySection.Header))
+	}
+	myTicket, err := NewTicket(mySection.Lines[0], len(validations))
+	if err != nil {
+		return nil, nil, nil, mySection.Locate(utils.AtLine(err, 1, mySection.Lines[0]))
+	}
+	tickets := make([]Ticket, 0)
+	for i, line := range nearbySection.Lines {
+		ticket, err := NewTicket(line, len(validations))
+		if err != nil {
+			return nil, nil, nil, nearbySection.Locate(utils.AtLine(err, i+1, line))
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return validations, myTicket, tickets, nil
+}
+
+// --------------------------- TICKET
+
+type Ticket []int
+
+func NewTicket(inputLine string, fieldCount int) (Ticket, error) {
+	values, err := utils.SplitToInts(inputLine, ",")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != fieldCount {
+		return nil, utils.NewParseError(1, inputLine, fmt.Errorf("ticket must have exactly %d values", fieldCount))
+	}
+
+	return values, nil
+}
+
+// --------------------------- TICKET ARRAY
+
+type Tickets []Ticket
+
+func (ts Tickets) isPossibleFieldPosition(position int, field TicketField) bool {
+	for _, ticket := range ts {
+		if !field.validateValue(ticket[position]) {
+			return false
+		}
+	}
+	return true
+}
+
+// --------------------------- TICKET FIELD
+
+type TicketField struct {
+	name      string
+	intervals []Interval
+}
+
+func NewTicketField(inputLine string) (TicketField, error) {
+	pattern := regexp.MustCompile("^([a-z ]+): (.*)$")
+	matches := pattern.FindStringSubmatch(inputLine)
+	if len(matches) != 3 {
+		return TicketField{}, utils.NewParseError(1, inputLine, fmt.Errorf("invalid ticket field, expected [name: a-b or c-d]"))
+	}
+
+	intervals := make([]Interval, 0)
+	column := len(matches[1]) + len(": ") + 1
+	for _, intervalString := range strings.Split(matches[2], " or ") {
+		interval, err := NewInterval(intervalString)
+		if err != nil {
+			return TicketField{}, utils.NewParseError(column, intervalString, err)
+		}
+		intervals = append(intervals, interval)
+		column += len(intervalString) + len(" or ")
+	}
+
+	return TicketField{
+		name:      matches[1],
+		intervals: intervals,
+	}, nil
+}
+
+func (f TicketField) validateValue(value int) bool {
+	for _, interval := range f.intervals {
+		if utils.IsInInterval(interval[0], interval[1], value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f TicketField) isDepartureField() bool {
+	departure := "departure"
+	if len(f.name) < len(departure) {
+		return false
+	}
+	return f.name[0:len(departure)] == departure
+}
+
+// --------------------------- TICKET FIELD ARRAY
+
+type TicketFields map[string]TicketField
+
+func (fa TicketFields) getInvalidValues(ticket Ticket) []int {
+	result := make([]int, 0)
+	for _, value := range ticket {
+		if !fa.valueIsValid(value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func (fa TicketFields) valueIsValid(value int) bool {
+	for _, field := range fa {
+		if field.validateValue(value) {
+			return true // valid at leas one validation
+		}
+	}
+
+	return false
+}
+
+func (fa TicketFields) getFieldPositions(tickets Tickets) (map[string]int, error) {
+	remainingFields := fa.getCopy()
+	remainingPositions := getPositionMap(len(fa))
+	fieldPositions := make(map[string]int)
+	iteration := 1
+	for len(remainingFields) > 0 {
+
+		var positionFound = false
+		for _, field := range remainingFields {
+			possiblePositionCounter := 0
+			lastPossiblePosition := -1
+			for position := range remainingPositions {
+				if tickets.isPossibleFieldPosition(position, field) {
+					lastPossiblePosition = position
+					possiblePositionCounter++
+				}
+			}
+			if possiblePositionCounter == 1 {
+				delete(remainingFields, field.name)
+				delete(remainingPositions, lastPossiblePosition)
+				fieldPositions[field.name] = lastPossiblePosition
+				positionFound = true
+			}
+		}
+
+		if !positionFound {
+			return nil, fmt.Errorf("no unique position found for any of the remaining fields in iteration [%d]", iteration)
+		}
+		iteration++
+	}
+
+	return fieldPositions, nil
+}
+
+func (fa TicketFields) getCopy() TicketFields {
+	result := make(map[string]TicketField)
+	for k, v := range fa {
+		result[k] = v
+	}
+
+	return result
+}
+
+func getPositionMap(size int) map[int]struct{} {
+	result := make(map[int]struct{})
+	for i := 0; i < size; i++ {
+		result[i] = struct{}{}
+	}
+
+	return result
+}
+
+// --------------------------- INTERVAL
+
+type Interval [2]int
+
+func NewInterval(intervalString string) (Interval, error) {
+	parts := strings.Split(intervalString, "-")
+	if len(parts) != 2 {
+		return Interval{}, fmt.Errorf("invalid interval, expected [a-b]")
+	}
+	a, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval start")
+	}
+	b, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval end")
+	}
+
+	return Interval{a, b}, nil
+}
@@ -0,0 +1,73 @@
+package day16
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetInvalidValues(t *testing.T) {
+	fields, _, tickets, err := readTicketInput(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]int{nil, {4}, {55}, {12}}
+	for i, ticket := range tickets {
+		got := fields.getInvalidValues(ticket)
+		if len(got) == 0 && len(want[i]) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("ticket %d: getInvalidValues() = %v, want %v", i+1, got, want[i])
+		}
+	}
+}
+
+func TestGetFieldPositions(t *testing.T) {
+	fields, _, tickets, err := readTicketInput(testutils.ExampleLines(t, examples, "example2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fields.getFieldPositions(tickets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{"row": 0, "class": 1, "seat": 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getFieldPositions() = %v, want %v", got, want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewTicketField(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		field, err := NewTicketField(line)
+		if err != nil {
+			return
+		}
+		if field.name == "" || len(field.intervals) == 0 {
+			t.Errorf("NewTicketField(%q) returned an incomplete field", line)
+		}
+		field.validateValue(0)
+		field.isDepartureField()
+	})
+}
+
+func FuzzNewTicket(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		ticket, err := NewTicket(line, 20)
+		if err != nil {
+			return
+		}
+		if len(ticket) != 20 {
+			t.Errorf("NewTicket(%q) has %d values, want 20", line, len(ticket))
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package day16
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 16, solver.Generator{
+		Defaults: solver.Params{"fields": 20, "tickets": 240},
+		Generate: generate,
+	})
+}
+
+var fieldNames = []string{
+	"departure location", "departure station", "departure platform", "departure track", "departure date",
+	"departure time", "arrival location", "arrival station", "arrival platform", "arrival track", "class", "duration",
+	"price", "route", "row", "seat", "train", "type", "wagon", "zone",
+}
+
+// generate creates nested field intervals, the field of rank r accepts the value bands r..n-1, so the field positions
+// can be found one by one (starting with the field accepting the least values); every position gets at least one
+// value from the band of its own rank, about a quarter of the nearby tickets has an invalid value
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("fields", 6, len(fieldNames)); err != nil {
+		return "", err
+	}
+	if err := params.Check("tickets", params["fields"], 10000); err != nil {
+		return "", err
+	}
+	count := params["fields"]
+
+	// the field of rank r accepts [starts[r], gapStart-1] or [gapEnd+1, ends[r]], the starts are increasing and the ends decreasing
+	starts, ends := make([]int, count), make([]int, count)
+	starts[0] = 20 + r.Intn(20)
+	for i := 1; i < count; i++ {
+		starts[i] = starts[i-1] + 1 + r.Intn(20)
+	}
+	gapStart := starts[count-1] + 5 + r.Intn(200)
+	gapEnd := gapStart + 1 + r.Intn(20)
+	ends[count-1] = gapEnd + 5 + r.Intn(200)
+	for i := count - 2; i >= 0; i-- {
+		ends[i] = ends[i+1] + 1 + r.Intn(20)
+	}
+	bandValue := func(band int) int {
+		lower := starts[band] + r.Intn(gapStart-starts[band])
+		if band < count-1 {
+			lower = starts[band] + r.Intn(starts[band+1]-starts[band])
+		}
+		upper := ends[band] - r.Intn(ends[band]-gapEnd)
+		if band < count-1 {
+			upper = ends[band] - r.Intn(ends[band]-ends[band+1])
+		}
+		if r.Intn(2) == 0 {
+			return lower
+		}
+		return upper
+	}
+	invalidValue := func() int {
+		switch r.Intn(3) {
+		case 0:
+			return r.Intn(starts[0])
+		case 1:
+			return gapStart + r.Intn(gapEnd-gapStart+1)
+		default:
+			return ends[0] + 1 + r.Intn(50)
+		}
+	}
+
+	names := gen.Shuffled(r, fieldNames[:count])  // name of the field of each rank
+	ranks := r.Perm(count)                        // rank of the field at each position
+	tickets := make([][]int, params["tickets"]+1) // my ticket is the first one
+	for i := range tickets {
+		tickets[i] = make([]int, count)
+		for position, rank := range ranks {
+			tickets[i][position] = bandValue(rank + r.Intn(count-rank))
+		}
+	}
+	valid := make([]int, 0, len(tickets))
+	for i, ticket := range tickets[1:] {
+		if i > 0 && r.Intn(4) == 0 { // the first nearby ticket is always valid
+			ticket[r.Intn(count)] = invalidValue()
+		} else {
+			valid = append(valid, i+1)
+		}
+	}
+	for position, rank := range ranks {
+		tickets[valid[r.Intn(len(valid))]][position] = bandValue(rank)
+	}
+
+	lines := make([]string, 0, count+params["tickets"]+5)
+	for rank, name := range names {
+		lines = append(lines, fmt.Sprintf("%s: %d-%d or %d-%d", name, starts[rank], gapStart-1, gapEnd+1, ends[rank]))
+	}
+	lines = append(lines, "", "your ticket:", joinValues(tickets[0]), "", "nearby tickets:")
+	for _, ticket := range tickets[1:] {
+		lines = append(lines, joinValues(ticket))
+	}
+
+	return gen.Lines(lines), nil
+}
+
+func joinValues(values []int) string {
+	items := make([]string, len(values))
+	for i, value := range values {
+		items[i] = strconv.Itoa(value)
+	}
+	return strings.Join(items, ",")
+}
@@ -1,37 +1,90 @@
-package main
+package day17
 
 import (
+	"embed"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/animate"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
 const activeCube = "#"
 const inactiveCube = "."
 const iterationCount = 6
 
-func main() {
-	// Part I.
-	pd3d := NewPocketDimension3D()
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 17, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.FrameRecorder
+	solver.ProgressReporter
+	lines []string
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if err = validateInitialState(lines); err != nil {
+		return err
+	}
+	s.lines = lines
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	pd3d := NewPocketDimension3D(s.lines)
+	s.recordFrame(1, pd3d.getFrame)
 	for i := 0; i < iterationCount; i++ {
 		pd3d = pd3d.execBootCycle()
+		s.recordFrame(1, pd3d.getFrame)
+		s.Progress().Report(i+1, iterationCount)
 	}
-	fmt.Printf("Total number of active cubes in 3D: %d\n", pd3d.getActiveCount())
 
-	// Part II.
-	pd4d := NewPocketDimension4D()
+	return strconv.Itoa(pd3d.getActiveCount()), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	pd4d := NewPocketDimension4D(s.lines)
+	s.recordFrame(2, pd4d.getFrame)
 	for i := 0; i < iterationCount; i++ {
 		pd4d = pd4d.execBootCycle()
+		s.recordFrame(2, pd4d.getFrame)
+		s.Progress().Report(i+1, iterationCount)
+	}
+
+	return strconv.Itoa(pd4d.getActiveCount()), nil
+}
+
+// recordFrame renders the frame only when the animation is recorded
+func (s *Solver) recordFrame(part int, getFrame func() *grid.Grid[byte]) {
+	if s.Animating() {
+		s.RecordFrame(part, getFrame())
 	}
-	fmt.Printf("Total number of active cubes in 4D: %d\n", pd4d.getActiveCount())
 }
 
 // -------------------------------------- 4D
 
 type Space4D []Space3D
 
-func NewPocketDimension4D() Space4D {
+func NewPocketDimension4D(lines []string) Space4D {
 	result := make(Space4D, 1)
-	result[0] = NewPocketDimension3D()
+	result[0] = NewPocketDimension3D(lines)
 
 	return result
 }
@@ -58,7 +111,7 @@ func (s4d *Space4D) getNewState(i, j, k, l int) string {
 }
 
 func (s4d *Space4D) isActive(i, j, k, l int) bool {
-	if !isInInterval(0, s4d.getSize()-1, i) {
+	if !utils.IsInInterval(0, s4d.getSize()-1, i) {
 		return false
 	}
 
@@ -109,6 +162,17 @@ func (s4d *Space4D) getWidth() int {
 	return (*s4d)[0].getWidth()
 }
 
+// getFrame renders the space as a table of 2D layers, one row for each w and one column for each z
+func (s4d *Space4D) getFrame() *grid.Grid[byte] {
+	layerWidth, layerHeight := s4d.getWidth()+1, s4d.getHeight()+1 // including the gap
+	frame := getEmptyFrame(s4d.getDepth()*layerWidth-1, s4d.getSize()*layerHeight-1)
+	for i, s3d := range *s4d {
+		s3d.drawLayers(frame, i*layerHeight)
+	}
+
+	return frame
+}
+
 func getEmptySpace4D(width int, height int, depth int, x int) Space4D {
 	result := make(Space4D, x)
 	for i := range result {
@@ -121,9 +185,24 @@ func getEmptySpace4D(width int, height int, depth int, x int) Space4D {
 
 type Space3D []Space2D
 
-func NewPocketDimension3D() Space3D {
-	lines := ReadLines("inputs/day_17.txt")
+func validateInitialState(lines []string) error {
+	if len(lines[0]) == 0 {
+		return utils.NewParseError(1, "", fmt.Errorf("empty initial state"))
+	}
+	allowed := activeCube + inactiveCube
+	for i, line := range lines {
+		if len(line) != len(lines[0]) {
+			return &utils.ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("line width differs from the first line (%d)", len(lines[0]))}
+		}
+		if j := strings.IndexFunc(line, func(r rune) bool { return !strings.ContainsRune(allowed, r) }); j >= 0 {
+			return &utils.ParseError{Line: i + 1, Column: j + 1, Text: line[j : j+1], Err: fmt.Errorf("unsupported character, expected one of [%s]", allowed)}
+		}
+	}
+
+	return nil
+}
 
+func NewPocketDimension3D(lines []string) Space3D {
 	result := make(Space3D, 1)
 	result[0] = lines
 
@@ -150,7 +229,7 @@ func (s3d *Space3D) getNewState(i, j, k int) string {
 }
 
 func (s3d *Space3D) isActive(i, j, k int) bool {
-	if !isInInterval(0, s3d.getDepth()-1, i) || !isInInterval(0, s3d.getHeight()-1, j) || !isInInterval(0, s3d.getWidth()-1, k) {
+	if !utils.IsInInterval(0, s3d.getDepth()-1, i) || !utils.IsInInterval(0, s3d.getHeight()-1, j) || !utils.IsInInterval(0, s3d.getWidth()-1, k) {
 		return false
 	}
 
@@ -197,6 +276,24 @@ func (s3d *Space3D) getWidth() int {
 	return len((*s3d)[0][0])
 }
 
+// getFrame renders the space as 2D layers side by side, one for each z
+func (s3d *Space3D) getFrame() *grid.Grid[byte] {
+	frame := getEmptyFrame(s3d.getDepth()*(s3d.getWidth()+1)-1, s3d.getHeight())
+	s3d.drawLayers(frame, 0)
+
+	return frame
+}
+
+func (s3d *Space3D) drawLayers(frame *grid.Grid[byte], row int) {
+	for i, s2d := range *s3d {
+		for j, line := range s2d {
+			for k := 0; k < len(line); k++ {
+				frame.Set(grid.Point{Row: row + j, Column: i*(s3d.getWidth()+1) + k}, line[k])
+			}
+		}
+	}
+}
+
 func getEmptySpace3D(width int, height int, depth int) Space3D {
 	result := make(Space3D, depth)
 	for i := range result {
@@ -219,6 +316,14 @@ func getEmptySpace2D(width int, height int) Space2D {
 
 // -------------------------------------- HELPERS
 
+func getEmptyFrame(width int, height int) *grid.Grid[byte] {
+	frame := grid.New[byte](width, height)
+	frame.Each(func(p grid.Point, _ byte) {
+		frame.Set(p, animate.Background)
+	})
+	return frame
+}
+
 func getNewState(adjacentActiveCount int, isActive bool) string {
 	if isActive {
 		adjacentActiveCount-- // getAdjacentActiveCount calculates all the active fields including the one in the middle
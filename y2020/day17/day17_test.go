@@ -0,0 +1,40 @@
+package day17
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestExecBootCycle(t *testing.T) {
+	lines := testutils.ExampleLines(t, examples, "example")
+
+	tests := []struct {
+		cycles int
+		want3D int
+		want4D int
+	}{
+		{1, 11, 29},
+		{2, 21, 60},
+		{iterationCount, 112, 848},
+	}
+	for _, tt := range tests {
+		pd3d := NewPocketDimension3D(lines)
+		pd4d := NewPocketDimension4D(lines)
+		for i := 0; i < tt.cycles; i++ {
+			pd3d = pd3d.execBootCycle()
+			pd4d = pd4d.execBootCycle()
+		}
+		if got := pd3d.getActiveCount(); got != tt.want3D {
+			t.Errorf("3D after %d cycles: %d active, want %d", tt.cycles, got, tt.want3D)
+		}
+		if got := pd4d.getActiveCount(); got != tt.want4D {
+			t.Errorf("4D after %d cycles: %d active, want %d", tt.cycles, got, tt.want4D)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
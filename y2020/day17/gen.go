@@ -0,0 +1,27 @@
+package day17
+
+import (
+	"math/rand"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 17, solver.Generator{
+		Defaults: solver.Params{"size": 8, "density": 50},
+		Generate: generate,
+	})
+}
+
+// generate creates a random square initial layer, density is the percentage of active cubes
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("size", 1, 30); err != nil {
+		return "", err
+	}
+	if err := params.Check("density", 0, 100); err != nil {
+		return "", err
+	}
+
+	return gen.Lines(gen.Pattern(r, params["size"], params["size"], float64(params["density"])/100, '#', '.')), nil
+}
@@ -1,24 +1,65 @@
-package main
+package day18
 
 import (
+	"embed"
 	"fmt"
+	"io"
 	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
 const operatorAdd = "+"
 const operatorMultiply = "*"
 
-func main() {
-	lines := ReadLines("inputs/day_18.txt")
-	sumV1, sumV2 := 0, 0
-	for _, line := range lines {
-		expression := NewExpression(line)
-		sumV1 += expression.evaluate()
-		expressionV2 := expression.modifyForPrecedence([]Operator{operatorAdd, operatorMultiply})
-		sumV2 += expressionV2.evaluate()
-	}
-	fmt.Printf("Sum of the given expressions: %d\n", sumV1)                                // Part I.
-	fmt.Printf("Sum of the given expressions with given operator precedence: %d\n", sumV2) // Part II.
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 18, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	expressions []Expression
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.expressions = make([]Expression, len(lines))
+	for i, line := range lines {
+		s.expressions[i], err = NewExpression(line)
+		if err != nil {
+			return utils.AtLine(err, i+1, line)
+		}
+	}
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	sum := 0
+	for _, expression := range s.expressions {
+		sum += expression.evaluate()
+	}
+
+	return strconv.Itoa(sum), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	sum := 0
+	for _, expression := range s.expressions {
+		sum += expression.modifyForPrecedence([]Operator{operatorAdd, operatorMultiply}).evaluate()
+	}
+
+	return strconv.Itoa(sum), nil
 }
 
 type Operator string
@@ -29,9 +70,9 @@ type Expression struct {
 	operators   []Operator
 }
 
-func NewExpression(line string) Expression {
-	expression, _ := readExpression(line, 0)
-	return expression
+func NewExpression(line string) (Expression, error) {
+	expression, _, err := readExpression(line, 0, 0)
+	return expression, err
 }
 
 func newNumberExpression(number int) Expression {
@@ -50,42 +91,76 @@ func newEmptyExpression() Expression {
 	}
 }
 
-func readExpression(line string, index int) (Expression, int) {
+func readExpression(line string, index int, depth int) (Expression, int, error) {
 	result := Expression{
 		number:      0,
 		expressions: make([]Expression, 0),
 		operators:   make([]Operator, 0),
 	}
 	startIndex := index
+	expectOperand := func() error {
+		if len(result.expressions) != len(result.operators) {
+			return utils.NewParseError(index+1, line[index:index+1], fmt.Errorf("operator expected"))
+		}
+		return nil
+	}
+	expectOperator := func() error {
+		if len(result.expressions) != len(result.operators)+1 {
+			return utils.NewParseError(index+1, line[index:index+1], fmt.Errorf("operand expected"))
+		}
+		return nil
+	}
 	for index < len(line) {
 		switch line[index] {
 		case ' ':
 			index++
 		case '(':
+			if err := expectOperand(); err != nil {
+				return Expression{}, 0, err
+			}
 			index++
-			subExpression, length := readExpression(line, index)
+			subExpression, length, err := readExpression(line, index, depth+1)
+			if err != nil {
+				return Expression{}, 0, err
+			}
 			result.expressions = append(result.expressions, subExpression)
 			index += length
 		case ')':
+			if depth == 0 {
+				return Expression{}, 0, utils.NewParseError(index+1, ")", fmt.Errorf("unexpected closing parenthesis"))
+			}
+			if err := expectOperator(); err != nil {
+				return Expression{}, 0, err
+			}
 			index++
-			return result, index - startIndex
-		case operatorAdd[0]:
-			result.operators = append(result.operators, operatorAdd)
-			index++
-		case operatorMultiply[0]:
-			result.operators = append(result.operators, operatorMultiply)
+			return result, index - startIndex, nil
+		case operatorAdd[0], operatorMultiply[0]:
+			if err := expectOperator(); err != nil {
+				return Expression{}, 0, err
+			}
+			result.operators = append(result.operators, Operator(line[index:index+1]))
 			index++
 		default:
 			number, err := strconv.Atoi(string(line[index]))
 			if err != nil {
-				panic(fmt.Errorf("invalid input [%s] - [%s] is not a number", line, string(line[index])))
+				return Expression{}, 0, utils.NewParseError(index+1, line[index:index+1], fmt.Errorf("not a number"))
+			}
+			if err = expectOperand(); err != nil {
+				return Expression{}, 0, err
 			}
 			result.expressions = append(result.expressions, newNumberExpression(number))
 			index++
 		}
 	}
 
-	return result, index - startIndex // end of line reached
+	if depth > 0 {
+		return Expression{}, 0, utils.NewParseError(len(line), line, fmt.Errorf("missing closing parenthesis"))
+	}
+	if len(result.expressions) != len(result.operators)+1 {
+		return Expression{}, 0, utils.NewParseError(len(line)+1, line, fmt.Errorf("incomplete expression"))
+	}
+
+	return result, index - startIndex, nil // end of line reached
 }
 
 func (e Expression) modifyForPrecedence(precedence []Operator) Expression {
@@ -136,7 +211,7 @@ func (e Expression) evaluate() int {
 
 	// compound expression
 	if len(e.expressions) != len(e.operators)+1 {
-		panic(fmt.Errorf("invalid expression, inconsistent number of operators and operands"))
+		panic(fmt.Errorf("invalid expression, inconsistent number of operators and operands")) // unreachable, readExpression rejects incomplete expressions
 	}
 
 	result := e.expressions[0].evaluate()
@@ -147,7 +222,7 @@ func (e Expression) evaluate() int {
 		case operatorMultiply:
 			result *= e.expressions[i].evaluate()
 		default:
-			panic(fmt.Errorf("unknown operator [%s]", e.operators[i-1]))
+			panic(fmt.Errorf("unknown operator [%s]", e.operators[i-1])) // unreachable, readExpression accepts only + and *
 		}
 	}
 
@@ -0,0 +1,52 @@
+package day18
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       int
+		wantV2     int
+	}{
+		{"1 + 2 * 3 + 4 * 5 + 6", 71, 231},
+		{"1 + (2 * 3) + (4 * (5 + 6))", 51, 51},
+		{"2 * 3 + (4 * 5)", 26, 46},
+		{"5 + (8 * 3 + 9 + 3 * 4 * 3)", 437, 1445},
+		{"5 * 9 * (7 * 3 * 3 + 9 * 3 + (8 + 6 * 4))", 12240, 669060},
+		{"((2 + 4 * 9) * (6 + 9 * 8 + 6) + 6) + 2 + 4 * 2", 13632, 23340},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expression, err := NewExpression(tt.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := expression.evaluate(); got != tt.want {
+				t.Errorf("evaluate() = %d, want %d", got, tt.want)
+			}
+			if got := expression.modifyForPrecedence([]Operator{operatorAdd, operatorMultiply}).evaluate(); got != tt.wantV2 {
+				t.Errorf("evaluate() with addition first = %d, want %d", got, tt.wantV2)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewExpression(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		expression, err := NewExpression(line)
+		if err != nil {
+			return
+		}
+		expression.evaluate()
+		expression.modifyForPrecedence([]Operator{operatorAdd, operatorMultiply}).evaluate()
+	})
+}
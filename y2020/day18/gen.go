@@ -0,0 +1,64 @@
+package day18
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 18, solver.Generator{
+		Defaults: solver.Params{"count": 370, "operands": 6, "depth": 3},
+		Generate: generate,
+	})
+}
+
+const maxResult = 1e13 // the upper bound of a single expression, keeps the sum of the results within int64
+
+// generate creates expressions with 2 to the given number of operands, each operand can be a nested expression
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 100000); err != nil {
+		return "", err
+	}
+	if err := params.Check("operands", 2, 10); err != nil {
+		return "", err
+	}
+	if err := params.Check("depth", 0, 5); err != nil {
+		return "", err
+	}
+	lines := make([]string, params["count"])
+	for i := range lines {
+		for {
+			expression, bound := getExpression(r, params["operands"], params["depth"])
+			if bound <= maxResult {
+				lines[i] = expression
+				break
+			}
+		}
+	}
+
+	return gen.Lines(lines), nil
+}
+
+// getExpression returns a random expression and an upper bound of its result with any operator precedence
+// (a+b <= a*b for a, b >= 2, so the bound is the product of the operands, each at least 2)
+func getExpression(r *rand.Rand, maxOperands int, depth int) (string, float64) {
+	var sb strings.Builder
+	bound := 1.0
+	for i := 0; i < 2+r.Intn(maxOperands-1); i++ {
+		if i > 0 {
+			sb.WriteString([]string{" + ", " * "}[r.Intn(2)])
+		}
+		operand, operandBound := strconv.Itoa(1+r.Intn(9)), 9.0
+		if depth > 0 && r.Intn(4) == 0 {
+			operand, operandBound = getExpression(r, maxOperands, depth-1)
+			operand = "(" + operand + ")"
+		}
+		sb.WriteString(operand)
+		bound *= max(operandBound, 2)
+	}
+	return sb.String(), bound
+}
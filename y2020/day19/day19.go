@@ -0,0 +1,251 @@
+package day19
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 19, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	rules    Rules
+	messages []string
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.rules, s.messages, err = readSatelliteMessages(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	v1Counter, _, err := countMatchingMessages(s.rules, s.messages)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(v1Counter), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	_, v2Counter, err := countMatchingMessages(s.rules, s.messages)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(v2Counter), nil
+}
+
+func countMatchingMessages(rules Rules, messages []string) (int, int, error) {
+	v1Counter, v2Counter := 0, 0
+
+	// The way the input data are specified, any matching message must be a 8 11. That expanded means 42 42 31 (this is without recursion in Part II)
+	// With recursion it grows to 42 42 42 31, 42 42 42 31 31 and so on so it's always a sequence of 42 "sub-matches" followed by a (smaller) sequence of 31 "sub-matches"
+	// Moreover, all the combinations of rules 42 and 31 have the same length (8 in the input, 5 in the example) so we can match the message by chunks
+	combinations42, combinations31 := rules.getCombinationsForRule(42), rules.getCombinationsForRule(31)
+	chunkSize := len(combinations42[0])
+	for _, combination := range append(combinations42, combinations31...) {
+		if len(combination) != chunkSize {
+			return 0, 0, fmt.Errorf("all the combinations of rules 42 and 31 must have the same length, found [%s] and [%s]", combinations42[0], combination)
+		}
+	}
+	sort.Strings(combinations42) // faster search in messageMatchesRule()
+	sort.Strings(combinations31)
+	for _, message := range messages {
+		if len(message)%chunkSize > 0 {
+			continue // no need to check messages with length not divisible by the chunk size
+		}
+
+		matchPattern := ""
+		for chunkNumber := 0; chunkNumber < len(message)/chunkSize; chunkNumber++ {
+			// Encode the "shape" of chunk matches in letters to create an easy-to-check pattern for this message
+			messageChunk := message[chunkSize*chunkNumber : chunkSize*(chunkNumber+1)]
+			if messageMatchesRule(messageChunk, combinations42) {
+				matchPattern += "A"
+			} else if messageMatchesRule(messageChunk, combinations31) {
+				matchPattern += "B"
+			} else {
+				matchPattern = "X" // not matching at all
+				break
+			}
+		}
+
+		if regexp.MustCompile("^AA+B+$").MatchString(matchPattern) {
+			//AAB, AAAB, AAABB allowed; AABA, BBA not...
+			if strings.Count(matchPattern, "A") > strings.Count(matchPattern, "B") {
+				// AAB, AAAB, AAABB allowed; AB AABB, ABBB not...
+				v2Counter++
+				if matchPattern == "AAB" {
+					v1Counter++ // "basic" version without loops
+				}
+			}
+		}
+	}
+
+	return v1Counter, v2Counter, nil
+}
+
+func readSatelliteMessages(lines []string) (Rules, []string, error) {
+	blocks, err := records.SplitN(lines, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	ruleBlock, messageBlock := blocks[0], blocks[1]
+
+	rules := make(Rules)
+	for i, line := range ruleBlock.Lines {
+		rule, err := NewRule(line)
+		if err != nil {
+			return nil, nil, ruleBlock.Locate(utils.AtLine(err, i+1, line))
+		}
+		if _, exists := rules[rule.index]; exists {
+			return nil, nil, ruleBlock.Locate(&utils.ParseError{Line: i + 1, Column: 1, Text: line, Err: fmt.Errorf("duplicate rule number")})
+		}
+		rules[rule.index] = rule
+	}
+	messages := messageBlock.Lines
+
+	for _, ruleIndex := range []int{42, 31} {
+		if err := rules.validate(ruleIndex, make(map[int]bool)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rules, messages, nil
+}
+
+func messageMatchesRule(message string, ruleCombinations []string) bool {
+	i := sort.SearchStrings(ruleCombinations, message)
+	return i < len(ruleCombinations) && ruleCombinations[i] == message
+}
+
+type Rule struct {
+	index       int
+	char        string
+	ruleOptions []RuleSequence
+}
+
+type RuleSequence []int
+
+func NewRule(line string) (Rule, error) {
+	parts := strings.Split(line, ": ")
+	if len(parts) != 2 {
+		return Rule{}, utils.NewParseError(1, line, fmt.Errorf("invalid format for rule, expected [number: definition]"))
+	}
+	index, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Rule{}, utils.NewParseError(1, parts[0], fmt.Errorf("invalid rule number"))
+	}
+
+	pattern := regexp.MustCompile("^\"([a-z])\"$")
+	matches := pattern.FindStringSubmatch(parts[1])
+	if len(matches) == 2 {
+		return Rule{
+			index:       index,
+			char:        matches[1],
+			ruleOptions: nil,
+		}, nil
+	}
+
+	ruleOptionsStrings := strings.Split(parts[1], " | ")
+	ruleOptions := make([]RuleSequence, len(ruleOptionsStrings))
+	column := len(parts[0]) + len(": ") + 1
+	for i, ruleSequenceString := range ruleOptionsStrings {
+		ruleIndexes := strings.Split(ruleSequenceString, " ")
+		ruleOptions[i] = make([]int, len(ruleIndexes))
+		for j, ruleIndex := range ruleIndexes {
+			ruleOptions[i][j], err = strconv.Atoi(ruleIndex)
+			if err != nil {
+				return Rule{}, utils.NewParseError(column, ruleIndex, fmt.Errorf("invalid rule index"))
+			}
+			column += len(ruleIndex) + len(" ")
+		}
+		column += len("| ")
+	}
+
+	return Rule{
+		index:       index,
+		char:        "",
+		ruleOptions: ruleOptions,
+	}, nil
+}
+
+type Rules map[int]Rule
+
+// validate checks the given rule and all its sub-rules are defined and there are no loops among them
+// (visiting holds true for rules being checked and false for the already checked ones)
+func (r Rules) validate(ruleIndex int, visiting map[int]bool) error {
+	rule, ok := r[ruleIndex]
+	if !ok {
+		return fmt.Errorf("rule [%d] not in rule set", ruleIndex)
+	}
+	if inProgress, checked := visiting[ruleIndex]; checked {
+		if inProgress {
+			return fmt.Errorf("rule [%d] contains a loop", ruleIndex)
+		}
+		return nil
+	}
+
+	visiting[ruleIndex] = true
+	for _, ruleOption := range rule.ruleOptions {
+		for _, subRuleIndex := range ruleOption {
+			if err := r.validate(subRuleIndex, visiting); err != nil {
+				return err
+			}
+		}
+	}
+	visiting[ruleIndex] = false
+
+	return nil
+}
+
+func (r *Rules) getCombinationsForRule(ruleIndex int) []string {
+	rule, ok := (*r)[ruleIndex]
+	if !ok {
+		panic(fmt.Errorf("rule index [%d] not in rule set", ruleIndex)) // unreachable, readSatelliteMessages validates the rules 42 and 31 with all their sub-rules
+	}
+
+	if rule.char != "" {
+		return []string{rule.char} // terminal rule, just return
+	}
+
+	combinations := make([]string, 0)
+	for _, ruleOption := range rule.ruleOptions {
+		// ruleOption = 3 1 4
+		optionCombinations := []string{""}
+		for _, subRuleIndex := range ruleOption {
+			// subRuleIndex = 3
+			subCombinations := r.getCombinationsForRule(subRuleIndex)
+			newOptionCombinations := make([]string, 0)
+			for _, newComb := range subCombinations {
+				for _, currentComb := range optionCombinations {
+					newOptionCombinations = append(newOptionCombinations, currentComb+newComb)
+				}
+			}
+			optionCombinations = newOptionCombinations
+		}
+		combinations = append(combinations, optionCombinations...)
+	}
+
+	return combinations
+}
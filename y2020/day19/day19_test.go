@@ -0,0 +1,56 @@
+package day19
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestCountMatchingMessages(t *testing.T) {
+	rules, messages, err := readSatelliteMessages(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v1Counter, v2Counter, err := countMatchingMessages(rules, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v1Counter != 3 {
+		t.Errorf("matching messages without loops = %d, want 3", v1Counter)
+	}
+	if v2Counter != 12 {
+		t.Errorf("matching messages with loops = %d, want 12", v2Counter)
+	}
+}
+
+func TestReferenceSolver(t *testing.T) {
+	s := &ReferenceSolver{}
+	if err := s.Parse(strings.NewReader(strings.Join(testutils.ExampleLines(t, examples, "example"), "\n"))); err != nil {
+		t.Fatal(err)
+	}
+	for part, want := range map[int]string{1: "3", 2: "12"} {
+		if got, err := solver.SolvePart(context.Background(), s, part); err != nil || got != want {
+			t.Errorf("part %d = (%s, %v), want %s", part, got, err, want)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewRule(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		rule, err := NewRule(line)
+		if err != nil {
+			return
+		}
+		if (rule.char == "") == (len(rule.ruleOptions) == 0) {
+			t.Errorf("NewRule(%q) must have either a character or options", line)
+		}
+	})
+}
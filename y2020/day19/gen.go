@@ -0,0 +1,131 @@
+package day19
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 19, solver.Generator{
+		Defaults: solver.Params{"chunk": 8, "messages": 450},
+		Generate: generate,
+	})
+}
+
+// generate creates the rules 0: 8 11, 8: 42 and 11: 42 31 like in the real input, the rules 42 and 31 match chunks of
+// the same length (the 42 chunks start with "a" and the 31 chunks with "b", so they never match the same chunk);
+// the messages are a mix of valid ones (for part one, part two or both) and invalid ones
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("chunk", 2, 8); err != nil {
+		return "", err
+	}
+	if err := params.Check("messages", 1, 10000); err != nil {
+		return "", err
+	}
+	g := &grammar{r: r, rules: make(Rules), free: r.Perm(1000)}
+	leafA, leafB := g.add(Rule{char: "a"}), g.add(Rule{char: "b"})
+	g.leaves = [2]int{leafA, leafB}
+	g.set(42, Rule{ruleOptions: []RuleSequence{{leafA, g.random(params["chunk"] - 1)}}})
+	g.set(31, Rule{ruleOptions: []RuleSequence{{leafB, g.random(params["chunk"] - 1)}}})
+	g.set(8, Rule{ruleOptions: []RuleSequence{{42}}})
+	g.set(11, Rule{ruleOptions: []RuleSequence{{42, 31}}})
+	g.set(0, Rule{ruleOptions: []RuleSequence{{8, 11}}})
+
+	lines := make([]string, 0, len(g.rules)+1+params["messages"])
+	for _, index := range g.indexes {
+		lines = append(lines, formatRule(g.rules[index]))
+	}
+	lines = append(gen.Shuffled(r, lines), "")
+
+	chunks := [2][]string{g.rules.getCombinationsForRule(42), g.rules.getCombinationsForRule(31)}
+	for i := 0; i < params["messages"]; i++ {
+		var sb strings.Builder
+		count42, count31 := 1+r.Intn(5), 1+r.Intn(3)
+		switch r.Intn(4) {
+		case 0:
+			count42 = 2 + r.Intn(count31) // invalid, not enough 42 chunks
+			count31 = count42
+		case 1:
+			for j := 0; j <= r.Intn(params["chunk"]); j++ {
+				sb.WriteByte("ab"[r.Intn(2)]) // invalid, random prefix
+			}
+		default:
+			count42 = count31 + 1 + r.Intn(3) // valid for part two (and part one for 42 42 31)
+		}
+		for j := 0; j < count42+count31; j++ {
+			chunk := chunks[0][r.Intn(len(chunks[0]))]
+			if j >= count42 {
+				chunk = chunks[1][r.Intn(len(chunks[1]))]
+			}
+			sb.WriteString(chunk)
+		}
+		lines = append(lines, sb.String())
+	}
+
+	return gen.Lines(lines), nil
+}
+
+// grammar creates random rules numbered by the free rule numbers (the special rules 0, 8, 11, 31 and 42 are skipped)
+type grammar struct {
+	r       *rand.Rand
+	rules   Rules
+	indexes []int // in the order of creation (the map order is random, but the output must be reproducible)
+	free    []int
+	leaves  [2]int // rules of the "a" and "b" characters
+}
+
+func (g *grammar) add(rule Rule) int {
+	for {
+		index := g.free[0]
+		g.free = g.free[1:]
+		if _, reserved := map[int]struct{}{0: {}, 8: {}, 11: {}, 31: {}, 42: {}}[index]; !reserved {
+			g.set(index, rule)
+			return index
+		}
+	}
+}
+
+func (g *grammar) set(index int, rule Rule) {
+	rule.index = index
+	g.rules[index] = rule
+	g.indexes = append(g.indexes, index)
+}
+
+// random creates a rule matching strings of the given length, it has one or two options, each made of two sub-rules
+func (g *grammar) random(length int) int {
+	if length == 1 {
+		switch g.r.Intn(3) {
+		case 0:
+			return g.leaves[0]
+		case 1:
+			return g.leaves[1]
+		default:
+			return g.add(Rule{ruleOptions: []RuleSequence{{g.leaves[0]}, {g.leaves[1]}}})
+		}
+	}
+	options := make([]RuleSequence, 1+g.r.Intn(2))
+	for i := range options {
+		split := 1 + g.r.Intn(length-1)
+		options[i] = RuleSequence{g.random(split), g.random(length - split)}
+	}
+	return g.add(Rule{ruleOptions: options})
+}
+
+func formatRule(rule Rule) string {
+	if rule.char != "" {
+		return fmt.Sprintf("%d: \"%s\"", rule.index, rule.char)
+	}
+	options := make([]string, len(rule.ruleOptions))
+	for i, option := range rule.ruleOptions {
+		items := make([]string, len(option))
+		for j, index := range option {
+			items[j] = fmt.Sprintf("%d", index)
+		}
+		options[i] = strings.Join(items, " ")
+	}
+	return fmt.Sprintf("%d: %s", rule.index, strings.Join(options, " | "))
+}
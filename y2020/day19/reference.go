@@ -0,0 +1,77 @@
+package day19
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterReference(2020, 19, solver.Reference{
+		New:   func() solver.Solver { return &ReferenceSolver{} },
+		Parts: []int{1, 2},
+	})
+}
+
+// ReferenceSolver matches the messages against rule 0 by trying all the options of all the rules, without relying on
+// the shape of the rules 0, 8 and 11 or on the chunks of the rules 42 and 31
+type ReferenceSolver struct {
+	Solver
+}
+
+func (s *ReferenceSolver) PartOne() (string, error) {
+	return countMatching(s.rules, s.messages)
+}
+
+func (s *ReferenceSolver) PartTwo() (string, error) {
+	rules := make(Rules, len(s.rules)+2)
+	for index, rule := range s.rules {
+		rules[index] = rule
+	}
+	rules[8] = Rule{index: 8, ruleOptions: []RuleSequence{{42}, {42, 8}}}
+	rules[11] = Rule{index: 11, ruleOptions: []RuleSequence{{42, 31}, {42, 11, 31}}}
+	return countMatching(rules, s.messages)
+}
+
+func countMatching(rules Rules, messages []string) (string, error) {
+	if _, ok := rules[0]; !ok {
+		return "", fmt.Errorf("rule [0] not in rule set")
+	}
+	count := 0
+	for _, message := range messages {
+		if slices.Contains(rules.match(0, message, 0), len(message)) {
+			count++
+		}
+	}
+	return strconv.Itoa(count), nil
+}
+
+// match returns all the positions where a match of the rule starting at the given position of the message can end
+// (the looping rules must match at least one letter before looping, otherwise the recursion never ends)
+func (r Rules) match(ruleIndex int, message string, start int) []int {
+	rule := r[ruleIndex] // an undefined rule has no options and matches nothing
+	if rule.char != "" {
+		if start < len(message) && message[start:start+1] == rule.char {
+			return []int{start + 1}
+		}
+		return nil
+	}
+
+	ends := make([]int, 0)
+	for _, ruleOption := range rule.ruleOptions {
+		positions := []int{start}
+		for _, subRuleIndex := range ruleOption {
+			next := make([]int, 0)
+			for _, position := range positions {
+				next = append(next, r.match(subRuleIndex, message, position)...)
+			}
+			slices.Sort(next)
+			positions = slices.Compact(next) // the same end reached by different options is matched only once
+		}
+		ends = append(ends, positions...)
+	}
+
+	return ends
+}
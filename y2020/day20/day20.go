@@ -0,0 +1,374 @@
+package day20
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const topIndex = 0
+const rightIndex = 1
+const bottomIndex = 2
+const leftIndex = 3
+
+var monsterPattern = []string{
+	"..................#.",
+	"#....##....##....###",
+	".#..#..#..#..#..#...",
+}
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 20, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	lines []string
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if _, err = getTiles(lines); err != nil {
+		return err
+	}
+	s.lines = lines // tiles are rotated and flipped while assembling the image, each part starts with new ones
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	tiles, _ := getTiles(s.lines)
+	image, err := NewImage(tiles)
+	if err != nil {
+		return "", err
+	}
+	cornerTileIdProduct := image.tilePlacement[0][0].id *
+		image.tilePlacement[0][image.imageSize-1].id *
+		image.tilePlacement[image.imageSize-1][0].id *
+		image.tilePlacement[image.imageSize-1][image.imageSize-1].id
+
+	return strconv.Itoa(cornerTileIdProduct), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	tiles, _ := getTiles(s.lines)
+	image, err := NewImage(tiles)
+	if err != nil {
+		return "", err
+	}
+	imageTile := image.getImageTile()
+	monsterPositions := imageTile.findMonsters(monsterPattern)
+	counterMonster := 0
+	for i := range monsterPattern {
+		counterMonster += strings.Count(monsterPattern[i], "#")
+	}
+	counterTotal := imageTile.content.Count(func(c byte) bool { return c == '#' })
+
+	return strconv.Itoa(counterTotal - len(monsterPositions)*counterMonster), nil
+}
+
+// --------------------------------------- IMAGE
+
+type Image struct {
+	tilePlacement [][]*Tile
+	imageSize     int
+}
+
+func NewImage(tiles Tiles) (*Image, error) {
+	imageSize := int(math.Sqrt(float64(len(tiles))))
+	tilePlacement := make([][]*Tile, imageSize)
+	for i := range tilePlacement {
+		tilePlacement[i] = make([]*Tile, imageSize)
+	}
+
+	// place tiles into the image
+	usedIds := make(map[int]struct{})
+	for i := 0; i < imageSize; i++ {
+		for j := 0; j < imageSize; j++ {
+			var tile *Tile
+			var err error
+			if i == 0 && j == 0 {
+				tile, err = tiles.findTopLeftCorner()
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				matchBorder := ""
+				borderIndex := -1
+				if j == 0 {
+					matchBorder = tilePlacement[i-1][j].borders[bottomIndex]
+					borderIndex = topIndex
+				} else {
+					matchBorder = tilePlacement[i][j-1].borders[rightIndex]
+					borderIndex = leftIndex
+				}
+
+				tile, err = tiles.findNext(matchBorder, borderIndex, usedIds)
+				if err != nil {
+					return nil, err
+				}
+				if i > 0 {
+					if tile.borders[topIndex] != tilePlacement[i-1][j].borders[bottomIndex] {
+						return nil, fmt.Errorf("tile id [%d] does not fit in the %d-th row and %d-th column (left border matches, but the top one does not)", tile.id, i, j)
+					}
+				}
+			}
+
+			tilePlacement[i][j] = tile
+			usedIds[tile.id] = struct{}{}
+		}
+	}
+
+	return &Image{
+		tilePlacement: tilePlacement,
+		imageSize:     imageSize,
+	}, nil
+}
+
+func (im *Image) getImageTile() *Tile {
+	tileSize := im.tilePlacement[0][0].size
+	imageTileSize := len(im.tilePlacement) * (tileSize - 2)
+	content := grid.New[byte](imageTileSize, imageTileSize)
+
+	for i := range im.tilePlacement {
+		for j := range im.tilePlacement[i] {
+			// only the non-border part of each tile
+			inner := im.tilePlacement[i][j].content.Sub(grid.Point{Row: 1, Column: 1}, tileSize-2, tileSize-2)
+			content.Paste(grid.Point{Row: i * (tileSize - 2), Column: j * (tileSize - 2)}, inner)
+		}
+	}
+
+	imageTile := Tile{
+		id:      0,
+		content: content,
+		size:    imageTileSize,
+	}
+	imageTile.updateBorders()
+
+	return &imageTile
+}
+
+// --------------------------------------- TILE ARRAY
+
+type Tiles []*Tile
+
+func getTiles(lines []string) (Tiles, error) {
+	headerPattern := regexp.MustCompile("^Tile ([0-9]+):$")
+	tiles := make(Tiles, 0)
+	usedIds := make(map[int]struct{})
+	for _, block := range records.Split(lines) {
+		header := block.Lines[0]
+		matches := headerPattern.FindStringSubmatch(header)
+		if matches == nil {
+			return nil, &utils.ParseError{Line: block.Line, Column: 1, Text: header, Err: fmt.Errorf("invalid tile header, expected [Tile <id>:]")}
+		}
+		id, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, &utils.ParseError{Line: block.Line, Column: 6, Text: matches[1], Err: fmt.Errorf("invalid tile id")}
+		}
+		if _, exists := usedIds[id]; exists {
+			return nil, &utils.ParseError{Line: block.Line, Column: 6, Text: matches[1], Err: fmt.Errorf("duplicate tile id")}
+		}
+		usedIds[id] = struct{}{}
+
+		tile, err := NewTile(id, block.Lines[1:])
+		if err != nil {
+			return nil, utils.OffsetLine(err, block.Line)
+		}
+		if len(tiles) > 0 && tile.size != tiles[0].size {
+			return nil, &utils.ParseError{Line: block.Line, Column: 1, Text: header, Err: fmt.Errorf("tile size differs from the first tile (%d)", tiles[0].size)}
+		}
+		tiles = append(tiles, &tile)
+	}
+
+	imageSize := int(math.Sqrt(float64(len(tiles))))
+	if len(tiles) == 0 || imageSize*imageSize != len(tiles) {
+		return nil, fmt.Errorf("number of tiles (%d) cannot form a square image", len(tiles))
+	}
+
+	return tiles, nil
+}
+
+func (ta Tiles) findTopLeftCorner() (*Tile, error) {
+	for i := 0; i < len(ta); i++ {
+		freeBorders := make([]int, 0, 2)
+		for j := range ta[i].borders {
+			currentBorder := ta[i].borders[j]
+			reversed := Reverse(currentBorder)
+			matchFound := false
+			for k := 0; k < len(ta) && !matchFound; k++ {
+				if k == i {
+					continue // do not match with itself
+				}
+				for l := range ta[k].borders {
+					if currentBorder == ta[k].borders[l] || reversed == ta[k].borders[l] {
+						matchFound = true
+						break
+					}
+				}
+			}
+			if !matchFound {
+				freeBorders = append(freeBorders, j)
+			}
+		}
+
+		if len(freeBorders) == 2 {
+			//This is a corner tile - rotate it the way it matches the TL corner (corners 3 and 0)
+			if utils.AbsInt(freeBorders[0]-freeBorders[1]) != 3 {
+				//not rotated properly
+				min := int(math.Min(float64(freeBorders[0]), float64(freeBorders[1])))
+				rotateSteps := (3 - min + 4) % 4
+				ta[i].rotateClockwise(rotateSteps)
+			}
+
+			return ta[i], nil // corner tile found and positioned correctly to TL orientation
+		}
+	}
+
+	return nil, fmt.Errorf("top left corner tile not found")
+}
+
+func (ta Tiles) findNext(borderToMatch string, wantBorderIndex int, exclude map[int]struct{}) (*Tile, error) {
+	for _, tile := range ta {
+		if _, ok := exclude[tile.id]; ok {
+			continue // this tile is already used
+		}
+		for haveBorderIndex, border := range tile.borders {
+			if border == borderToMatch || Reverse(border) == borderToMatch {
+				tile.rotateClockwise((wantBorderIndex - haveBorderIndex + 4) % 4)
+				if tile.borders[wantBorderIndex] != borderToMatch {
+					if wantBorderIndex%2 == 0 {
+						tile.flipHorizontal() // the matched (reversed) border is top or bottom -> flip horizontally
+					} else {
+						tile.flipVertical()
+					}
+				}
+				return tile, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no suitable tile found matching border [%s] on side [%d]", borderToMatch, wantBorderIndex)
+}
+
+// --------------------------------------- TILE
+
+type Tile struct {
+	id      int
+	borders [4]string // top, right, bottom, left
+	content *grid.Grid[byte]
+	size    int
+}
+
+func NewTile(id int, lines []string) (Tile, error) {
+	if len(lines) < 3 {
+		return Tile{}, fmt.Errorf("tile [%d] must have at least 3 lines", id)
+	}
+	content, err := grid.ParseBytes(lines, "#.")
+	if err != nil {
+		return Tile{}, err
+	}
+	if content.Width() != content.Height() {
+		return Tile{}, &utils.ParseError{Line: 1, Column: 1, Text: lines[0], Err: fmt.Errorf("tile must be a square (%d characters wide)", len(lines))}
+	}
+
+	tile := Tile{
+		id:      id,
+		size:    len(lines),
+		content: content,
+	}
+	tile.updateBorders()
+
+	return tile, nil
+}
+
+func (t *Tile) updateBorders() {
+	t.borders = [4]string{
+		string(t.content.Row(0)),
+		string(t.content.Column(t.size - 1)),
+		string(t.content.Row(t.size - 1)),
+		string(t.content.Column(0)),
+	}
+}
+
+func (t *Tile) flipVertical() {
+	t.content = t.content.FlipVertical()
+	t.updateBorders()
+}
+
+func (t *Tile) flipHorizontal() {
+	t.content = t.content.FlipHorizontal()
+	t.updateBorders()
+}
+
+func (t *Tile) rotateClockwise(steps int) {
+	t.content = t.content.RotateClockwise(steps)
+	t.updateBorders()
+}
+
+// findMonsters rotates and flips the tile until at least one monster is found, returns their positions
+func (t *Tile) findMonsters(monsterPattern []string) []grid.Point {
+	pattern, err := grid.ParseBytes(monsterPattern, "#.")
+	if err != nil {
+		panic(err) // the pattern is a constant
+	}
+	for _, content := range t.content.Orientations() {
+		t.content = content
+		monsterPositions := t.findPattern(pattern)
+		if len(monsterPositions) > 0 {
+			t.updateBorders()
+			return monsterPositions
+		}
+	}
+
+	return nil // no monsters found
+}
+
+// findPattern returns the top left corners of all the places where all the "#" of the pattern are "#" in the tile
+func (t *Tile) findPattern(pattern *grid.Grid[byte]) []grid.Point {
+	matchCorners := make([]grid.Point, 0)
+	for i := 0; i+pattern.Height() <= t.size; i++ {
+		for j := 0; j+pattern.Width() <= t.size; j++ {
+			corner := grid.Point{Row: i, Column: j}
+			matches := true
+			pattern.Each(func(p grid.Point, value byte) {
+				if matches && value == '#' && t.content.At(corner.Add(p)) != '#' {
+					matches = false
+				}
+			})
+			if matches {
+				matchCorners = append(matchCorners, corner)
+			}
+		}
+	}
+
+	return matchCorners
+}
+
+func Reverse(s string) string {
+	n := len(s)
+	runes := make([]rune, n)
+	for _, r := range s {
+		n--
+		runes[n] = r
+	}
+	return string(runes[n:])
+}
@@ -0,0 +1,77 @@
+package day20
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestNewImage(t *testing.T) {
+	tiles, err := getTiles(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	image, err := NewImage(tiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := image.imageSize - 1
+	corners := []int{
+		image.tilePlacement[0][0].id,
+		image.tilePlacement[0][last].id,
+		image.tilePlacement[last][0].id,
+		image.tilePlacement[last][last].id,
+	}
+	sort.Ints(corners)
+	want := []int{1171, 1951, 2971, 3079}
+	for i := range want {
+		if corners[i] != want[i] {
+			t.Fatalf("corner tiles are %v, want %v", corners, want)
+		}
+	}
+}
+
+func TestFindMonsters(t *testing.T) {
+	tiles, err := getTiles(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	image, err := NewImage(tiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monsterPositions := image.getImageTile().findMonsters(monsterPattern)
+	if len(monsterPositions) != 2 {
+		t.Errorf("found %d monsters, want 2", len(monsterPositions))
+	}
+
+	s := &Solver{lines: testutils.ExampleLines(t, examples, "example")}
+	roughness, err := s.PartTwo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roughness != "273" {
+		t.Errorf("water roughness is [%s], want [273]", roughness)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewTile(f *testing.F) {
+	f.Fuzz(func(t *testing.T, block string) {
+		tile, err := NewTile(1, strings.Split(block, "\n"))
+		if err != nil {
+			return
+		}
+		tile.rotateClockwise(1)
+		tile.flipHorizontal()
+		tile.findMonsters(monsterPattern)
+	})
+}
@@ -0,0 +1,169 @@
+package day20
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 20, solver.Generator{
+		Defaults: solver.Params{"tiles": 144, "size": 10, "monsters": 20},
+		Generate: generate,
+	})
+}
+
+const maxAttempts = 1000
+
+// generate creates a sparse image with the given number of monsters and cuts it to randomly rotated and flipped tiles;
+// the borders of the neighbouring tiles are the same and every border is unique (even when reversed), so there is
+// exactly one way to assemble the image
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("tiles", 1, 400); err != nil {
+		return "", err
+	}
+	if err := params.Check("size", 4, 20); err != nil {
+		return "", err
+	}
+	if err := params.Check("monsters", 0, 1000); err != nil {
+		return "", err
+	}
+	imageSize := int(math.Sqrt(float64(params["tiles"])))
+	if imageSize*imageSize != params["tiles"] {
+		return "", fmt.Errorf("number of tiles (%d) cannot form a square image", params["tiles"])
+	}
+	tileSize := params["size"]
+
+	image, err := getImage(r, imageSize*(tileSize-2), params["monsters"])
+	if err != nil {
+		return "", err
+	}
+	raw, err := getRawImage(r, image, imageSize, tileSize)
+	if err != nil {
+		return "", err
+	}
+
+	ids := r.Perm(9000)[:params["tiles"]] // 4-digit ids
+	tiles := make([]string, 0, params["tiles"])
+	for i := 0; i < imageSize; i++ {
+		for j := 0; j < imageSize; j++ {
+			tile := raw.Sub(grid.Point{Row: i * tileSize, Column: j * tileSize}, tileSize, tileSize)
+			tile = tile.Orientations()[r.Intn(8)]
+			header := fmt.Sprintf("Tile %d:", 1000+ids[i*imageSize+j])
+			tiles = append(tiles, header+"\n"+strings.TrimSuffix(tile.Render(func(c byte) byte { return c }), "\n"))
+		}
+	}
+
+	return gen.Lines([]string{strings.Join(gen.Shuffled(r, tiles), "\n\n")}), nil
+}
+
+// getImage creates a sparse random image with the monsters which do not overlap
+func getImage(r *rand.Rand, size int, monsters int) (*grid.Grid[byte], error) {
+	monster, err := grid.ParseBytes(monsterPattern, "#.")
+	if err != nil {
+		return nil, err
+	}
+	if monsters > 0 && (size < monster.Width() || size < monster.Height()) {
+		return nil, fmt.Errorf("image of size %d is too small for the monsters", size)
+	}
+	image := grid.New[byte](size, size)
+	occupied := grid.New[bool](size, size)
+	image.Each(func(p grid.Point, _ byte) {
+		image.Set(p, '.')
+		if r.Intn(10) < 3 {
+			image.Set(p, '#')
+		}
+	})
+
+	for planted, attempt := 0, 0; planted < monsters; attempt++ {
+		if attempt > maxAttempts*monsters {
+			return nil, fmt.Errorf("failed to place %d monsters to an image of size %d", monsters, size)
+		}
+		corner := grid.Point{Row: r.Intn(size - monster.Height() + 1), Column: r.Intn(size - monster.Width() + 1)}
+		free := true
+		monster.Each(func(p grid.Point, _ byte) {
+			free = free && !occupied.At(corner.Add(p))
+		})
+		if !free {
+			continue
+		}
+		monster.Each(func(p grid.Point, value byte) {
+			occupied.Set(corner.Add(p), true)
+			if value == '#' {
+				image.Set(corner.Add(p), '#')
+			}
+		})
+		planted++
+	}
+
+	return image, nil
+}
+
+// getRawImage puts the image inside the tiles (without the borders) and adds the random borders, the corners of the
+// borders are shared by up to 4 tiles
+func getRawImage(r *rand.Rand, image *grid.Grid[byte], imageSize int, tileSize int) (*grid.Grid[byte], error) {
+	raw := grid.New[byte](imageSize*tileSize, imageSize*tileSize)
+	corners := grid.New[byte](imageSize+1, imageSize+1)
+	corners.Each(func(p grid.Point, _ byte) {
+		corners.Set(p, "#."[r.Intn(2)])
+	})
+	used := make(map[string]struct{})
+	border := func(first byte, last byte) (string, error) {
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			middle := strings.Map(func(_ rune) rune { return rune("#."[r.Intn(2)]) }, strings.Repeat(".", tileSize-2))
+			value := string(first) + middle + string(last)
+			_, exists := used[value]
+			if value == Reverse(value) || exists {
+				continue
+			}
+			used[value], used[Reverse(value)] = struct{}{}, struct{}{}
+			return value, nil
+		}
+		return "", fmt.Errorf("failed to find a unique border, try bigger tiles")
+	}
+
+	for i := 0; i <= imageSize; i++ {
+		for j := 0; j <= imageSize; j++ {
+			if j < imageSize { // horizontal border, the bottom of the tile above and the top of the tile below
+				value, err := border(corners.At(grid.Point{Row: i, Column: j}), corners.At(grid.Point{Row: i, Column: j + 1}))
+				if err != nil {
+					return nil, err
+				}
+				for k := 0; k < tileSize; k++ {
+					for _, row := range []int{i*tileSize - 1, i * tileSize} {
+						if row >= 0 && row < raw.Height() {
+							raw.Set(grid.Point{Row: row, Column: j*tileSize + k}, value[k])
+						}
+					}
+				}
+			}
+			if i < imageSize { // vertical border, the right side of the tile on the left and the left side of the tile on the right
+				value, err := border(corners.At(grid.Point{Row: i, Column: j}), corners.At(grid.Point{Row: i + 1, Column: j}))
+				if err != nil {
+					return nil, err
+				}
+				for k := 0; k < tileSize; k++ {
+					for _, column := range []int{j*tileSize - 1, j * tileSize} {
+						if column >= 0 && column < raw.Width() {
+							raw.Set(grid.Point{Row: i*tileSize + k, Column: column}, value[k])
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for i := 0; i < imageSize; i++ {
+		for j := 0; j < imageSize; j++ {
+			content := image.Sub(grid.Point{Row: i * (tileSize - 2), Column: j * (tileSize - 2)}, tileSize-2, tileSize-2)
+			raw.Paste(grid.Point{Row: i*tileSize + 1, Column: j*tileSize + 1}, content)
+		}
+	}
+
+	return raw, nil
+}
@@ -1,27 +1,65 @@
-package main
+package day21
 
 import (
+	"embed"
 	"fmt"
+	"io"
 	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
 )
 
-func main() {
-	foodList := getFoodList()
-	allergenInIngredient, ingredientHasAllergen := foodList.getAllergenMapping()
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 21, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	foodList FoodList
+}
 
-	// Part I.
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.foodList, err = getFoodList(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	_, ingredientHasAllergen, err := s.foodList.getAllergenMapping()
+	if err != nil {
+		return "", err
+	}
 	counter := 0
-	for _, food := range foodList.foods {
+	for _, food := range s.foodList.foods {
 		for _, ingredient := range food.ingredients {
 			if _, ok := ingredientHasAllergen[ingredient]; !ok {
 				counter++
 			}
 		}
 	}
-	fmt.Printf("Sum of occurences of ingredients without allergens: %d\n", counter)
 
-	// Part II.
+	return strconv.Itoa(counter), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	allergenInIngredient, _, err := s.foodList.getAllergenMapping()
+	if err != nil {
+		return "", err
+	}
 	allergens := make([]string, 0)
 	for allergen := range allergenInIngredient {
 		allergens = append(allergens, allergen)
@@ -31,15 +69,24 @@ func main() {
 	for i, allergen := range allergens {
 		result[i] = allergenInIngredient[allergen]
 	}
-	fmt.Printf("Sorted list of ingredients with allergens: [%s]\n", strings.Join(result, ","))
+
+	return strings.Join(result, ","), nil
 }
 
-func getFoodList() FoodList {
-	lines := ReadLines("inputs/day_21.txt")
-	foods := make([]Food, len(lines))
+func getFoodList(lines []string) (FoodList, error) {
+	blocks, err := records.SplitN(lines, 1) // one food per line, a blank line in between is an error
+	if err != nil {
+		return FoodList{}, err
+	}
+	block := blocks[0]
+	foods := make([]Food, len(block.Lines))
 	allergenInFood := make(map[string][]int)
-	for i := range lines {
-		foods[i] = NewFood(lines[i])
+	for i, line := range block.Lines {
+		food, err := NewFood(line)
+		if err != nil {
+			return FoodList{}, block.Locate(utils.AtLine(err, i+1, line))
+		}
+		foods[i] = food
 		for _, al := range foods[i].allergens {
 			if _, ok := allergenInFood[al]; ok {
 				allergenInFood[al] = append(allergenInFood[al], i)
@@ -52,7 +99,7 @@ func getFoodList() FoodList {
 	return FoodList{
 		foods:          foods,
 		allergenInFood: allergenInFood,
-	}
+	}, nil
 }
 
 type FoodList struct {
@@ -60,7 +107,7 @@ type FoodList struct {
 	foods          []Food
 }
 
-func (fl FoodList) getAllergenMapping() (map[string]string, map[string]string) {
+func (fl FoodList) getAllergenMapping() (map[string]string, map[string]string, error) {
 	alToIng, ingToAl := make(map[string]string), make(map[string]string)
 	counter := 1
 	for len(alToIng) < len(fl.allergenInFood) {
@@ -79,12 +126,12 @@ func (fl FoodList) getAllergenMapping() (map[string]string, map[string]string) {
 			}
 		}
 		if counter > len(fl.allergenInFood) {
-			panic(fmt.Errorf("no unique allergen mapping found")) // to prevent infinite loop
+			return nil, nil, fmt.Errorf("no unique allergen mapping found") // to prevent infinite loop
 		}
 		counter++
 	}
 
-	return alToIng, ingToAl
+	return alToIng, ingToAl, nil
 }
 
 func (fl FoodList) getCommonIngredients(foodIndexes []int) []string {
@@ -114,11 +161,21 @@ type Food struct {
 	allergens   []string
 }
 
-func NewFood(line string) Food {
+func NewFood(line string) (Food, error) {
 	parts := strings.Split(line, " (contains ")
+	if len(parts) != 2 || !strings.HasSuffix(parts[1], ")") {
+		return Food{}, utils.NewParseError(1, line, fmt.Errorf("invalid food, expected [<ingredients> (contains <allergens>)]"))
+	}
+	ingredientsString := strings.TrimSpace(parts[0])
+	if ingredientsString == "" {
+		return Food{}, utils.NewParseError(1, line, fmt.Errorf("no ingredients listed"))
+	}
 	allergensString := parts[1][:len(parts[1])-1] // strip the trailing ")"
+	if allergensString == "" {
+		return Food{}, utils.NewParseError(len(parts[0])+len(" (contains ")+1, parts[1], fmt.Errorf("no allergens listed"))
+	}
 	return Food{
-		ingredients: strings.Split(strings.TrimSpace(parts[0]), " "),
+		ingredients: strings.Split(ingredientsString, " "),
 		allergens:   strings.Split(allergensString, ", "),
-	}
+	}, nil
 }
@@ -0,0 +1,46 @@
+package day21
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetAllergenMapping(t *testing.T) {
+	foodList, err := getFoodList(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allergenInIngredient, ingredientHasAllergen, err := foodList.getAllergenMapping()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"dairy": "mxmxvkd", "fish": "sqjhc", "soy": "fvjkl"}
+	if !reflect.DeepEqual(allergenInIngredient, want) {
+		t.Errorf("allergen mapping = %v, want %v", allergenInIngredient, want)
+	}
+	for _, ingredient := range []string{"kfcds", "nhms", "sbzzf", "trh"} {
+		if _, ok := ingredientHasAllergen[ingredient]; ok {
+			t.Errorf("ingredient [%s] cannot contain any allergen", ingredient)
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewFood(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		food, err := NewFood(line)
+		if err != nil {
+			return
+		}
+		if len(food.ingredients) == 0 || len(food.allergens) == 0 {
+			t.Errorf("NewFood(%q) = %v, want both ingredients and allergens", line, food)
+		}
+	})
+}
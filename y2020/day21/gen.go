@@ -0,0 +1,81 @@
+package day21
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 21, solver.Generator{
+		Defaults: solver.Params{"foods": 40, "ingredients": 200, "allergens": 8},
+		Generate: generate,
+	})
+}
+
+var allergenNames = []string{"dairy", "eggs", "fish", "nuts", "peanuts", "sesame", "shellfish", "soy", "wheat", "gluten"}
+
+// generate creates foods with a hidden mapping of the allergens to the ingredients, more foods are added until the
+// mapping can be found by elimination
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("allergens", 1, len(allergenNames)); err != nil {
+		return "", err
+	}
+	if err := params.Check("ingredients", params["allergens"], 10000); err != nil {
+		return "", err
+	}
+	if err := params.Check("foods", 1, 10000); err != nil {
+		return "", err
+	}
+	allergens := gen.Shuffled(r, allergenNames)[:params["allergens"]]
+	ingredients := gen.Words(r, params["ingredients"], 3, 8)
+	hidden := make(map[string]string, len(allergens)) // allergen -> ingredient, the first ingredients contain the allergens
+	for i, allergen := range allergens {
+		hidden[allergen] = ingredients[i]
+	}
+
+	lines := make([]string, 0, params["foods"])
+	for len(lines) < 10*params["foods"] {
+		listed := gen.Subset(r, allergens, 1.5/float64(len(allergens)))
+		if len(listed) == 0 {
+			listed = []string{allergens[r.Intn(len(allergens))]}
+		}
+		contained := make([]string, 0)
+		for i, ingredient := range ingredients {
+			isListed := false
+			for _, allergen := range listed {
+				isListed = isListed || hidden[allergen] == ingredient
+			}
+			if isListed || (i < len(allergens) && r.Intn(3) == 0) || r.Intn(5) == 0 {
+				contained = append(contained, ingredient)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s (contains %s)", strings.Join(gen.Shuffled(r, contained), " "), strings.Join(listed, ", ")))
+
+		if len(lines) >= params["foods"] && isSolvable(lines, hidden) {
+			return gen.Lines(lines), nil
+		}
+	}
+
+	return "", fmt.Errorf("no unique allergen mapping found in %d foods", len(lines))
+}
+
+func isSolvable(lines []string, hidden map[string]string) bool {
+	foodList, err := getFoodList(lines)
+	if err != nil {
+		return false
+	}
+	mapping, _, err := foodList.getAllergenMapping()
+	if err != nil || len(mapping) != len(hidden) {
+		return false
+	}
+	for allergen, ingredient := range hidden {
+		if mapping[allergen] != ingredient {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,277 @@
+package day22
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/records"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 22, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	solver.ProgressReporter
+	solver.DetailsRecorder
+	lines []string
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if _, err = getGame(lines); err != nil {
+		return err
+	}
+	s.lines = lines // decks are modified by the game, each part starts with new ones
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	game, _ := getGame(s.lines)
+	winner, err := game.playV1(s.Context())
+	if err != nil {
+		return "", err
+	}
+	s.Record(1, solver.Details{"winner": winner, "cards": len(game.decks[winner-1].queue)})
+	return strconv.Itoa(game.decks[winner-1].getScore()), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	game, _ := getGame(s.lines)
+	winner, err := game.playV2(s.Context(), s.Progress())
+	if err != nil {
+		return "", err
+	}
+	s.Record(2, solver.Details{"winner": winner, "cards": len(game.decks[winner-1].queue)})
+	return strconv.Itoa(game.decks[winner-1].getScore()), nil
+}
+
+func getGame(lines []string) (Game, error) {
+	sections, err := records.Sections(records.Split(lines), "Player 1:", "Player 2:")
+	if err != nil {
+		return Game{}, err
+	}
+	deck1, err := NewDeck(sections[0].Lines)
+	if err != nil {
+		return Game{}, sections[0].Locate(err)
+	}
+	deck2, err := NewDeck(sections[1].Lines)
+	if err != nil {
+		return Game{}, sections[1].Locate(err)
+	}
+
+	// equal cards would make the round winner undefined
+	cards := make(map[int]struct{})
+	for _, card := range append(append([]int{}, deck1.queue...), deck2.queue...) {
+		if _, exists := cards[card]; exists {
+			return Game{}, fmt.Errorf("card [%d] is in the decks more than once", card)
+		}
+		cards[card] = struct{}{}
+	}
+
+	return NewGame(deck1, deck2), nil
+}
+
+type Game struct {
+	decks  [2]Deck
+	memory [2]DeckMemory
+}
+
+func NewGame(deck1 Deck, deck2 Deck) Game {
+	return Game{
+		decks:  [2]Deck{deck1, deck2},
+		memory: [2]DeckMemory{make(DeckMemory), make(DeckMemory)},
+	}
+}
+
+const noWinner = 0
+
+// playV1 plays the combat, a game which gets back to the decks of an earlier round would never end
+func (g *Game) playV1(ctx context.Context) (int, error) {
+	rounds := make(map[string]struct{})
+	for round := 1; g.getGameWinner() == noWinner; round++ {
+		if err := solver.Interrupted(ctx, "round %d", round); err != nil {
+			return 0, err
+		}
+		fingerprint := g.decks[0].getFingerprint() + "/" + g.decks[1].getFingerprint()
+		if _, ok := rounds[fingerprint]; ok {
+			return 0, fmt.Errorf("round %d repeats an earlier one, the game never ends", round)
+		}
+		rounds[fingerprint] = struct{}{}
+		g.playRound()
+	}
+	return g.getGameWinner(), nil
+}
+
+// playV2 plays the recursive combat, the rounds of this game (not the sub-games) are reported to the progress function,
+// their total is not known in advance
+func (g *Game) playV2(ctx context.Context, progress solver.ProgressFunc) (int, error) {
+	for round := 1; g.getGameWinner() == noWinner; round++ {
+		if g.isInMemory() {
+			return 1, nil // loop in this game -> player 1 wins
+		}
+		if err := solver.Interrupted(ctx, "round %d of a game with %d cards", round, len(g.decks[0].queue)+len(g.decks[1].queue)); err != nil {
+			return 0, err
+		}
+		g.saveToMemory()
+		if err := g.playRoundV2(ctx); err != nil {
+			return 0, err
+		}
+		progress.Report(round, 0)
+	}
+	return g.getGameWinner(), nil
+}
+
+func (g *Game) playRound() {
+	cards := [2]int{g.decks[0].get(), g.decks[1].get()}
+	winner := g.getRoundWinnerV1(cards)
+	g.processRoundWinner(winner, cards)
+}
+
+func (g *Game) playRoundV2(ctx context.Context) error {
+	cards := [2]int{g.decks[0].get(), g.decks[1].get()}
+
+	var winner int
+	if cards[0] > len(g.decks[0].queue) || cards[1] > len(g.decks[1].queue) {
+		// someone has not enough cards, play according to the v1 rules
+		winner = g.getRoundWinnerV1(cards)
+	} else {
+		//play a sub-game
+		subGame := g.getSubGame(cards)
+		var err error
+		if winner, err = subGame.playV2(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	g.processRoundWinner(winner, cards)
+	return nil
+}
+
+func (g *Game) processRoundWinner(winner int, cards [2]int) {
+	g.decks[winner-1].add(cards[winner-1]) // first the winners card
+	g.decks[winner-1].add(cards[winner%2])
+}
+
+func (g *Game) getRoundWinnerV1(cards [2]int) int {
+	if cards[0] > cards[1] {
+		return 1
+	} else if cards[1] > cards[0] {
+		return 2
+	}
+	panic(fmt.Errorf("invalid deck - values [%d, %d] are the same", cards[0], cards[1])) // unreachable, getGame rejects the cards in the decks more than once
+}
+
+func (g *Game) getSubGame(cards [2]int) Game {
+	queue1 := make([]int, cards[0])
+	copy(queue1, g.decks[0].queue[0:cards[0]])
+	queue2 := make([]int, cards[1])
+	copy(queue2, g.decks[1].queue[0:cards[1]])
+	return NewGame(Deck{queue: queue1}, Deck{queue: queue2})
+}
+
+func (g *Game) getGameWinner() int {
+	if g.decks[0].isEmpty() {
+		return 2
+	}
+	if g.decks[1].isEmpty() {
+		return 1
+	}
+	return noWinner
+}
+
+func (g *Game) saveToMemory() {
+	g.memory[0][g.decks[0].getFingerprint()] = struct{}{}
+	g.memory[1][g.decks[1].getFingerprint()] = struct{}{}
+}
+
+func (g *Game) isInMemory() bool {
+	_, ok1 := g.memory[0][g.decks[0].getFingerprint()]
+	_, ok2 := g.memory[0][g.decks[0].getFingerprint()]
+	return ok1 || ok2
+}
+
+type DeckMemory map[string]struct{}
+
+type Deck struct {
+	queue []int
+}
+
+func NewDeck(lines []string) (Deck, error) {
+	queue, err := utils.StringsToInts(lines)
+	if err != nil {
+		return Deck{}, err
+	}
+	for i, card := range queue {
+		if card < 1 {
+			return Deck{}, &utils.ParseError{Line: i + 1, Column: 1, Text: lines[i], Err: fmt.Errorf("card must be a positive number")}
+		}
+	}
+	if len(queue) == 0 {
+		return Deck{}, fmt.Errorf("deck is empty")
+	}
+	return Deck{
+		queue: queue,
+	}, nil
+}
+
+func (d *Deck) add(card int) {
+	d.queue = append(d.queue, card)
+	if cap(d.queue) > 3*len(d.queue) {
+		d.optimize()
+	}
+}
+
+func (d *Deck) get() int {
+	if len(d.queue) == 0 {
+		panic(fmt.Errorf("cannot draw from an empty deck")) // unreachable, the rounds are played only while both decks have cards
+	}
+	value := d.queue[0]
+	d.queue = d.queue[1:]
+	return value
+}
+
+func (d *Deck) isEmpty() bool {
+	return len(d.queue) == 0
+}
+
+func (d *Deck) getScore() int {
+	score := 0
+	for i := 0; i < len(d.queue); i++ {
+		score += d.queue[i] * (len(d.queue) - i)
+	}
+
+	return score
+}
+
+func (d *Deck) optimize() {
+	// memory optimization (slice-based queues ten to grow indefinitely in Go)
+	newQueue := make([]int, len(d.queue))
+	copy(newQueue, d.queue)
+	d.queue = newQueue
+}
+
+func (d *Deck) getFingerprint() string {
+	fingerprint := ""
+	for i := range d.queue {
+		fingerprint += strconv.Itoa(d.queue[i]) + "|"
+	}
+	return fingerprint
+}
@@ -0,0 +1,101 @@
+package day22
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestPlay(t *testing.T) {
+	lines := testutils.ExampleLines(t, examples, "example")
+
+	tests := []struct {
+		name       string
+		play       func(g *Game) int
+		wantWinner int
+		wantScore  int
+	}{
+		{"combat", func(g *Game) int {
+			winner, err := g.playV1(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return winner
+		}, 2, 306},
+		{"recursive combat", func(g *Game) int {
+			winner, err := g.playV2(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return winner
+		}, 2, 291},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := getGame(lines)
+			if err != nil {
+				t.Fatal(err)
+			}
+			winner := tt.play(&game)
+			if winner != tt.wantWinner {
+				t.Errorf("winner is player %d, want player %d", winner, tt.wantWinner)
+			}
+			if got := game.decks[winner-1].getScore(); got != tt.wantScore {
+				t.Errorf("winning score = %d, want %d", got, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestPlayV2InfiniteGame(t *testing.T) {
+	game, err := getGame(testutils.Lines(t, "Player 1:\n43\n19\n\nPlayer 2:\n2\n29\n14\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	winner, err := game.playV2(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if winner != 1 {
+		t.Errorf("repeated round must end the game in favor of player 1, got player %d", winner)
+	}
+}
+
+func TestPlayV1InfiniteGame(t *testing.T) {
+	game, err := getGame(testutils.Lines(t, "Player 1:\n43\n19\n\nPlayer 2:\n2\n29\n14\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if winner, err := game.playV1(context.Background()); err == nil {
+		t.Errorf("playV1() = player %d, want an error of the repeated round", winner)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = game.playV1(ctx)
+	var interrupted *solver.InterruptedError
+	if !errors.As(err, &interrupted) {
+		t.Errorf("playV1() error = %v, want interrupted", err)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzNewDeck(f *testing.F) {
+	f.Fuzz(func(t *testing.T, block string) {
+		deck, err := NewDeck(strings.Split(block, "\n"))
+		if err != nil {
+			return
+		}
+		if deck.isEmpty() {
+			t.Errorf("NewDeck(%q) returned an empty deck", block)
+		}
+		deck.getScore()
+	})
+}
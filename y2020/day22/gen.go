@@ -0,0 +1,32 @@
+package day22
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 22, solver.Generator{
+		Defaults: solver.Params{"cards": 25},
+		Generate: generate,
+	})
+}
+
+// generate shuffles the cards 1..2*cards and deals them to both players
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("cards", 1, 1000); err != nil {
+		return "", err
+	}
+	lines := []string{"Player 1:"}
+	for i, card := range r.Perm(2 * params["cards"]) {
+		if i == params["cards"] {
+			lines = append(lines, "", "Player 2:")
+		}
+		lines = append(lines, strconv.Itoa(card+1))
+	}
+
+	return gen.Lines(lines), nil
+}
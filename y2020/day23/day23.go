@@ -0,0 +1,188 @@
+package day23
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 23, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	solver.ProgressReporter
+	cups []int
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.cups, err = readCups(lines[0])
+	return utils.AtLine(err, 1, lines[0])
+}
+
+func (s *Solver) PartOne() (string, error) {
+	gameV1 := NewCupGame(s.cups, len(s.cups))
+	if err := gameV1.play(s.Context(), 100, s.Progress()); err != nil {
+		return "", err
+	}
+	cupOrder := ""
+	startCup := gameV1.cups[1]
+	for currentCup := startCup.next; currentCup != startCup; currentCup = currentCup.next {
+		cupOrder += strconv.Itoa(currentCup.value)
+	}
+
+	return cupOrder, nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	gameV2 := NewCupGame(s.cups, 1000000)
+	if err := gameV2.play(s.Context(), 10000000, s.Progress()); err != nil {
+		return "", err
+	}
+	cup1 := gameV2.cups[1]
+
+	return strconv.Itoa(cup1.next.value * cup1.next.next.value), nil
+}
+
+const minCups = 5 // current cup, three picked up ones and at least one possible destination
+
+func readCups(line string) ([]int, error) {
+	cups, err := utils.SplitToInts(line, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(cups) < minCups {
+		return nil, utils.NewParseError(1, line, fmt.Errorf("at least %d cups expected", minCups))
+	}
+	used := make(map[int]struct{}, len(cups))
+	for i, cup := range cups {
+		if cup < 1 || cup > len(cups) {
+			return nil, utils.NewParseError(i+1, line[i:i+1], fmt.Errorf("cup labels must be between 1 and %d", len(cups)))
+		}
+		if _, exists := used[cup]; exists {
+			return nil, utils.NewParseError(i+1, line[i:i+1], fmt.Errorf("duplicate cup label"))
+		}
+		used[cup] = struct{}{}
+	}
+
+	return cups, nil
+}
+
+type CupGame struct {
+	cups        map[int]*Cup
+	currentCup  *Cup
+	roundNumber int
+}
+
+type Cup struct {
+	value int
+	next  *Cup
+}
+
+func NewCupGame(cups []int, gameSize int) CupGame {
+	cupList := make(map[int]*Cup, gameSize)
+	var previousCup *Cup
+	lastValue := 0
+	for i := 0; i < gameSize; i++ {
+		value := i + 1 // values start from 1...
+		if i < len(cups) {
+			value = cups[i]
+		}
+		cupList[value] = &Cup{
+			value: value,
+			next:  nil,
+		}
+		lastValue = value
+		if previousCup != nil {
+			previousCup.next = cupList[value] // link with previous cup
+		}
+		previousCup = cupList[value]
+	}
+
+	cupList[lastValue].next = cupList[cups[0]] // close the circle
+
+	return CupGame{
+		cups:        cupList,
+		currentCup:  cupList[cups[0]],
+		roundNumber: 0,
+	}
+}
+
+// play plays the given number of rounds, it stops early when the context is cancelled
+// (the rounds are reported to the progress function every check interval)
+func (g *CupGame) play(ctx context.Context, rounds int, progress solver.ProgressFunc) error {
+	for round := 0; round < rounds; round++ {
+		if round%solver.CheckInterval == 0 {
+			if err := solver.Interrupted(ctx, "round %d of %d", round+1, rounds); err != nil {
+				return err
+			}
+			progress.Report(round, rounds)
+		}
+		g.playRound()
+	}
+	progress.Report(rounds, rounds)
+	return nil
+}
+
+func (g *CupGame) playRound() {
+	g.roundNumber++
+
+	//get destination cup
+	destinationCup := g.getDestinationCup()
+
+	//move the 3 selected cups to their new position
+	g.moveCups(destinationCup)
+
+	//get the new current cup
+	g.currentCup = g.currentCup.next
+}
+
+func (g *CupGame) getDestinationCup() *Cup {
+
+	selectedValues := make(map[int]struct{})
+	for selected := g.currentCup.next; selected != g.getLastSelected().next; selected = selected.next {
+		selectedValues[selected.value] = struct{}{}
+	}
+	destinationCupValue := g.currentCup.value - 1
+	for i := 0; i < len(selectedValues)+1; i++ {
+		if destinationCupValue == 0 {
+			destinationCupValue = len(g.cups) // reached zero, we want the highest remaining number so reset back to the game size
+		}
+		if _, ok := selectedValues[destinationCupValue]; !ok {
+			return g.cups[destinationCupValue]
+		}
+		destinationCupValue--
+	}
+
+	panic(fmt.Errorf("destination cup not found")) // unreachable, readCups requires at least minCups cups labeled 1 to n
+}
+
+func (g *CupGame) moveCups(destinationCup *Cup) {
+	first := g.currentCup.next
+	last := g.getLastSelected()
+	g.currentCup.next = last.next   // remove selected block from list
+	last.next = destinationCup.next // link selected block after the destination cup
+	destinationCup.next = first
+}
+
+func (g *CupGame) getLastSelected() *Cup {
+	return g.currentCup.next.next.next
+}
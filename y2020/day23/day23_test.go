@@ -0,0 +1,77 @@
+package day23
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func getCupOrder(game CupGame) string {
+	cupOrder := ""
+	startCup := game.cups[1]
+	for currentCup := startCup.next; currentCup != startCup; currentCup = currentCup.next {
+		cupOrder += strconv.Itoa(currentCup.value)
+	}
+	return cupOrder
+}
+
+func TestPlayRound(t *testing.T) {
+	cups, err := readCups("389125467")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		rounds int
+		want   string
+	}{
+		{1, "54673289"},
+		{10, "92658374"},
+		{100, "67384529"},
+	}
+	for _, tt := range tests {
+		game := NewCupGame(cups, len(cups))
+		for round := 0; round < tt.rounds; round++ {
+			game.playRound()
+		}
+		if got := getCupOrder(game); got != tt.want {
+			t.Errorf("after %d rounds the cups are [%s], want [%s]", tt.rounds, got, tt.want)
+		}
+	}
+}
+
+func TestPlayRoundMillionCups(t *testing.T) {
+	if testing.Short() {
+		t.Skip("long game skipped in short mode")
+	}
+	s := &Solver{cups: []int{3, 8, 9, 1, 2, 5, 4, 6, 7}}
+	got, err := s.PartTwo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "149245887792" {
+		t.Errorf("product of the cups after cup 1 is [%s], want [149245887792]", got)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzReadCups(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		cups, err := readCups(line)
+		if err != nil {
+			return
+		}
+		used := make(map[int]bool, len(cups))
+		for _, cup := range cups {
+			if cup < 1 || cup > len(cups) || used[cup] {
+				t.Errorf("readCups(%q) = %v, want a permutation of 1..%d", line, cups, len(cups))
+			}
+			used[cup] = true
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package day23
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 23, solver.Generator{
+		Defaults: solver.Params{"cups": 9},
+		Generate: generate,
+	})
+}
+
+// generate shuffles the cup labels 1..cups (the labels are single digits)
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("cups", minCups, 9); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, cup := range r.Perm(params["cups"]) {
+		sb.WriteByte(byte('1' + cup))
+	}
+
+	return gen.Lines([]string{sb.String()}), nil
+}
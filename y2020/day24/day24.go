@@ -0,0 +1,233 @@
+package day24
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/grid"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 24, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	solver.ContextHolder
+	solver.FrameRecorder
+	solver.ProgressReporter
+	flipInstructions []FlipInstruction
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	s.flipInstructions, err = getFlipInstructions(lines)
+	return err
+}
+
+func (s *Solver) PartOne() (string, error) {
+	floor, err := getInitialFloor(s.Context(), s.flipInstructions)
+	if err != nil {
+		return "", err
+	}
+	if s.Animating() {
+		s.RecordFrame(1, floor.getFrame(floor.getBounds(0)))
+	}
+	return strconv.Itoa(floor.countBlack()), nil
+}
+
+func (s *Solver) PartTwo() (string, error) {
+	floor, err := getInitialFloor(s.Context(), s.flipInstructions)
+	if err != nil {
+		return "", err
+	}
+	var bounds Bounds
+	if s.Animating() {
+		bounds = floor.getBounds(days) // all the frames have the same size
+		s.RecordFrame(2, floor.getFrame(bounds))
+	}
+	for i := 0; i < days; i++ {
+		if err := solver.Interrupted(s.Context(), "day %d of %d", i+1, days); err != nil {
+			return "", err
+		}
+		floor = floor.execIteration()
+		if s.Animating() {
+			s.RecordFrame(2, floor.getFrame(bounds))
+		}
+		s.Progress().Report(i+1, days)
+	}
+
+	return strconv.Itoa(floor.countBlack()), nil
+}
+
+const days = 100
+
+func getInitialFloor(ctx context.Context, flipInstructions []FlipInstruction) (Floor, error) {
+	floor := make(Floor)
+	for i, instruction := range flipInstructions {
+		if i%solver.CheckInterval == 0 {
+			if err := solver.Interrupted(ctx, "instruction %d of %d", i+1, len(flipInstructions)); err != nil {
+				return nil, err
+			}
+		}
+		target := instruction.getTargetCoordinates()
+		if floor[target] {
+			delete(floor, target) // flip back to white
+		} else {
+			floor[target] = true
+		}
+	}
+
+	return floor, nil
+}
+
+type FlipInstruction string
+
+func getFlipInstructions(lines []string) ([]FlipInstruction, error) {
+	result := make([]FlipInstruction, len(lines))
+	for i := range lines {
+		instruction := FlipInstruction(lines[i])
+		if _, err := instruction.parse(); err != nil {
+			return nil, utils.AtLine(err, i+1, lines[i])
+		}
+		result[i] = instruction
+	}
+	return result, nil
+}
+
+func (i FlipInstruction) getTargetCoordinates() grid.Point {
+	target := grid.Point{}
+	steps, _ := i.parse() // validated when reading the instructions
+	for _, step := range steps {
+		target = target.Add(stepIncrements[step])
+	}
+
+	return target
+}
+
+func (i FlipInstruction) parse() ([]string, error) {
+	result := make([]string, 0)
+	position := 0
+	for position < len(i) {
+		end := position + 1 // default is one letter ("e" or "w")
+		if i[position] == 'n' || i[position] == 's' {
+			end++ // 2-letter direction
+		}
+		if end > len(i) {
+			end = len(i)
+		}
+		step := string(i[position:end])
+		if _, ok := stepIncrements[step]; !ok {
+			return nil, utils.NewParseError(position+1, step, fmt.Errorf("invalid step, expected one of [e, se, sw, w, nw, ne]"))
+		}
+		result = append(result, step)
+		position = end
+	}
+
+	return result, nil
+}
+
+// Floor is the set of the black tiles of the hexagonal grid in doubled coordinates - the east and west neighbors
+// are two columns apart, so only the points with even sum of the row and column are tiles (the reference tile is 0,0);
+// only the black tiles are stored, so the far away tiles of long instructions cost nothing
+type Floor map[grid.Point]bool
+
+func (f Floor) execIteration() Floor {
+	blackNeighboursCounts := make(map[grid.Point]int, len(f)*len(hexNeighbours))
+	for tile := range f {
+		for _, direction := range hexNeighbours {
+			blackNeighboursCounts[tile.Add(direction)]++
+		}
+	}
+
+	newFloor := make(Floor, len(f))
+	for tile, blackNeighboursCount := range blackNeighboursCounts {
+		// a black tile with 0 or more than 2 black neighbours turns white, a white one with exactly 2 turns black
+		if blackNeighboursCount == 2 || (blackNeighboursCount == 1 && f[tile]) {
+			newFloor[tile] = true
+		}
+	}
+
+	return newFloor
+}
+
+// Bounds is the rectangle of the floor rendered into the frames
+type Bounds struct {
+	topLeft     grid.Point
+	bottomRight grid.Point
+}
+
+// getBounds is the rectangle of the black tiles, large enough for them to spread for the given number of days
+func (f Floor) getBounds(days int) Bounds {
+	var bounds Bounds
+	first := true
+	for tile := range f {
+		if first {
+			bounds, first = Bounds{topLeft: tile, bottomRight: tile}, false
+		}
+		bounds.topLeft.Row = min(bounds.topLeft.Row, tile.Row)
+		bounds.topLeft.Column = min(bounds.topLeft.Column, tile.Column)
+		bounds.bottomRight.Row = max(bounds.bottomRight.Row, tile.Row)
+		bounds.bottomRight.Column = max(bounds.bottomRight.Column, tile.Column)
+	}
+
+	// black tiles spread by one tile a day (one row or two columns), one more is needed for the white border
+	bounds.topLeft = bounds.topLeft.Add(grid.Point{Row: -(days + 1), Column: -2 * (days + 1)})
+	bounds.bottomRight = bounds.bottomRight.Add(grid.Point{Row: days + 1, Column: 2 * (days + 1)})
+	return bounds
+}
+
+// getFrame renders each tile as two cells, the rows are shifted by one cell, so the tiles are laid out like bricks
+func (f Floor) getFrame(bounds Bounds) *grid.Grid[byte] {
+	frame := grid.New[byte](bounds.bottomRight.Column-bounds.topLeft.Column+2, bounds.bottomRight.Row-bounds.topLeft.Row+1)
+	frame.Each(func(p grid.Point, _ byte) {
+		tile := bounds.topLeft.Add(p)
+		if (tile.Row+tile.Column)%2 != 0 {
+			tile = tile.Add(grid.Left) // the right half of the tile
+		}
+		if f[tile] {
+			frame.Set(p, '#')
+		} else {
+			frame.Set(p, '.')
+		}
+	})
+
+	return frame
+}
+
+func (f Floor) countBlack() int {
+	return len(f)
+}
+
+var stepIncrements = map[string]grid.Point{
+	"e":  {Row: 0, Column: 2},
+	"w":  {Row: 0, Column: -2},
+	"se": {Row: 1, Column: 1},
+	"sw": {Row: 1, Column: -1},
+	"ne": {Row: -1, Column: 1},
+	"nw": {Row: -1, Column: -1},
+}
+
+var hexNeighbours = []grid.Point{
+	stepIncrements["e"],
+	stepIncrements["se"],
+	stepIncrements["sw"],
+	stepIncrements["w"],
+	stepIncrements["nw"],
+	stepIncrements["ne"],
+}
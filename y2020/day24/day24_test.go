@@ -0,0 +1,83 @@
+package day24
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetTargetCoordinates(t *testing.T) {
+	tests := []struct {
+		instruction FlipInstruction
+		sameAs      FlipInstruction
+	}{
+		{"nwwswee", ""},
+		{"esew", "se"},
+		{"nenw", "nwne"},
+	}
+	for _, tt := range tests {
+		got := tt.instruction.getTargetCoordinates()
+		want := tt.sameAs.getTargetCoordinates()
+		if got != want {
+			t.Errorf("[%s] leads to %s, want %s", tt.instruction, got, want)
+		}
+	}
+}
+
+func TestExecIteration(t *testing.T) {
+	flipInstructions, err := getFlipInstructions(testutils.ExampleLines(t, examples, "example"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	floor, err := getInitialFloor(context.Background(), flipInstructions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if floor.countBlack() != 10 {
+		t.Fatalf("initial floor has %d black tiles, want 10", floor.countBlack())
+	}
+	want := map[int]int{1: 15, 2: 12, 10: 37, 50: 566, 100: 2208}
+	for day := 1; day <= days; day++ {
+		floor = floor.execIteration()
+		if wantCount, ok := want[day]; ok && floor.countBlack() != wantCount {
+			t.Errorf("day %d: %d black tiles, want %d", day, floor.countBlack(), wantCount)
+		}
+	}
+}
+
+func TestExecIterationFarTiles(t *testing.T) {
+	flipInstructions, err := getFlipInstructions([]string{strings.Repeat("ne", 200000), "e", "w"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	floor, err := getInitialFloor(context.Background(), flipInstructions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for day := 0; day < days; day++ {
+		floor = floor.execIteration()
+	}
+	if floor.countBlack() != 0 {
+		t.Errorf("%d black tiles after %d days, want 0", floor.countBlack(), days)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzFlipInstruction(f *testing.F) {
+	f.Fuzz(func(t *testing.T, line string) {
+		steps, err := FlipInstruction(line).parse()
+		if err != nil {
+			return
+		}
+		if got := strings.Join(steps, ""); got != line {
+			t.Errorf("parse(%q) steps join to %q", line, got)
+		}
+	})
+}
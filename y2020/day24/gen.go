@@ -0,0 +1,37 @@
+package day24
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 24, solver.Generator{
+		Defaults: solver.Params{"count": 500, "steps": 20},
+		Generate: generate,
+	})
+}
+
+// generate creates random paths of 1 to the given number of steps, some tiles get flipped more than once
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("count", 1, 10000); err != nil {
+		return "", err
+	}
+	if err := params.Check("steps", 1, 100); err != nil {
+		return "", err
+	}
+	directions := []string{"e", "se", "sw", "w", "nw", "ne"}
+	lines := make([]string, params["count"])
+	for i := range lines {
+		var sb strings.Builder
+		for j := 0; j < 1+r.Intn(params["steps"]); j++ {
+			sb.WriteString(directions[r.Intn(len(directions))])
+		}
+		lines[i] = sb.String()
+	}
+
+	return gen.Lines(lines), nil
+}
@@ -0,0 +1,89 @@
+package day25
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/numtheory"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/utils"
+)
+
+const subjectNumber = 7
+const keyLimit = 20201227
+
+//go:embed examples
+var examples embed.FS
+
+//go:embed *.go
+var sources embed.FS
+
+func init() {
+	solver.Register(2020, 25, func() solver.Solver {
+		return &Solver{}
+	}, examples, sources)
+}
+
+type Solver struct {
+	cardPublicKey int
+	doorPublicKey int
+}
+
+func (s *Solver) Parse(input io.Reader) error {
+	lines, err := utils.ReadLines(input)
+	if err != nil {
+		return err
+	}
+	if len(lines) != 2 {
+		return fmt.Errorf("exactly 2 public keys expected (card and door), got %d lines", len(lines))
+	}
+	publicKeys, err := utils.StringsToInts(lines)
+	if err != nil {
+		return err
+	}
+	for i, key := range publicKeys {
+		if key < 1 || key >= keyLimit {
+			return &utils.ParseError{Line: i + 1, Column: 1, Text: lines[i], Err: fmt.Errorf("public key must be between 1 and %d", keyLimit-1)}
+		}
+	}
+	s.cardPublicKey, s.doorPublicKey = publicKeys[0], publicKeys[1]
+	return nil
+}
+
+func (s *Solver) PartOne() (string, error) {
+	cardLoopSize, err := getLoopSize(s.cardPublicKey)
+	if err != nil {
+		return "", err
+	}
+	doorLoopSize, err := getLoopSize(s.doorPublicKey)
+	if err != nil {
+		return "", err
+	}
+	encryptionKey := loop(s.doorPublicKey, cardLoopSize)
+	if encryptionKey != loop(s.cardPublicKey, doorLoopSize) {
+		return "", fmt.Errorf("invalid loop sizes [%d, %d], door and card encryption keys do not match", cardLoopSize, doorLoopSize)
+	}
+
+	return strconv.Itoa(encryptionKey), nil
+}
+
+// PartTwo is not a puzzle on the last day (the star is given for completing all the other days)
+func (s *Solver) PartTwo() (string, error) {
+	return "", nil
+}
+
+// getLoopSize finds how many times the subject number must be transformed to get the public key (discrete logarithm)
+func getLoopSize(publicKey int) (int, error) {
+	loopSize, err := numtheory.DiscreteLog(subjectNumber, int64(publicKey), keyLimit)
+	if err != nil {
+		return 0, fmt.Errorf("loop size for public key [%d] not found: %w", publicKey, err)
+	}
+
+	return int(loopSize), nil
+}
+
+func loop(publicKey int, loopSize int) int {
+	return int(numtheory.ModPow(int64(publicKey), int64(loopSize), keyLimit))
+}
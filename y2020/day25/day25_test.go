@@ -0,0 +1,40 @@
+package day25
+
+import (
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/testutils"
+)
+
+func TestGetLoopSize(t *testing.T) {
+	tests := []struct {
+		publicKey int
+		want      int
+	}{
+		{5764801, 8},
+		{17807724, 11},
+	}
+	for _, tt := range tests {
+		got, err := getLoopSize(tt.publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("getLoopSize(%d) = %d, want %d", tt.publicKey, got, tt.want)
+		}
+	}
+}
+
+func TestLoop(t *testing.T) {
+	if got := loop(17807724, 8); got != 14897079 {
+		t.Errorf("encryption key from the door public key = %d, want 14897079", got)
+	}
+	if got := loop(5764801, 11); got != 14897079 {
+		t.Errorf("encryption key from the card public key = %d, want 14897079", got)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	testutils.FuzzParse(f, examples, func() solver.Solver { return &Solver{} })
+}
@@ -0,0 +1,29 @@
+package day25
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/gen"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+func init() {
+	solver.RegisterGenerator(2020, 25, solver.Generator{
+		Defaults: solver.Params{"max-loop": 20000000},
+		Generate: generate,
+	})
+}
+
+// generate creates the public keys of the card and the door from random loop sizes between 1 and max-loop
+func generate(r *rand.Rand, params solver.Params) (string, error) {
+	if err := params.Check("max-loop", 1, keyLimit-2); err != nil {
+		return "", err
+	}
+	lines := make([]string, 2)
+	for i := range lines {
+		lines[i] = strconv.Itoa(loop(subjectNumber, 1+r.Intn(params["max-loop"])))
+	}
+
+	return gen.Lines(lines), nil
+}
@@ -0,0 +1,47 @@
+package y2020_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+)
+
+// slowParts are too slow for the generated inputs in -short mode regardless of the input size
+var slowParts = map[string]bool{"2020/15/2": true, "2020/23/2": true}
+
+// TestGenerators solves the generated inputs of every day (any error or panic fails the test)
+func TestGenerators(t *testing.T) {
+	for _, puzzle := range solver.All() {
+		t.Run(puzzle.String(), func(t *testing.T) {
+			if _, ok := puzzle.Generator(); !ok {
+				t.Fatalf("no input generator registered")
+			}
+			for seed := int64(1); seed <= 3; seed++ {
+				input, err := puzzle.Generate(seed, nil)
+				if err != nil {
+					t.Fatalf("seed %d: Generate() error: %s", seed, err)
+				}
+				again, _ := puzzle.Generate(seed, nil)
+				if again != input {
+					t.Fatalf("seed %d: Generate() is not reproducible", seed)
+				}
+
+				s := puzzle.New()
+				if err = s.Parse(strings.NewReader(input)); err != nil {
+					t.Fatalf("seed %d: Parse() error: %s\n%s", seed, err, input)
+				}
+				for part := 1; part <= 2; part++ {
+					if testing.Short() && slowParts[fmt.Sprintf("%s/%d", puzzle, part)] {
+						continue
+					}
+					if _, err = solver.SolvePart(context.Background(), s, part); err != nil {
+						t.Errorf("seed %d: part %d error: %s", seed, part, err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package y2020_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/verify"
+)
+
+// TestReferences compares the optimized solvers with their naive references on the generated inputs
+func TestReferences(t *testing.T) {
+	seeds := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if testing.Short() {
+		seeds = seeds[:3]
+	}
+	for _, puzzle := range solver.All() {
+		if _, ok := puzzle.Reference(); !ok {
+			continue
+		}
+		t.Run(puzzle.String(), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			disagreements, err := verify.Differential(ctx, puzzle, seeds, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, disagreement := range disagreements {
+				t.Errorf("%s%s", disagreement, disagreement.Input)
+			}
+		})
+	}
+}
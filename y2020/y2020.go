@@ -0,0 +1,30 @@
+// Package y2020 registers all the solutions of the 2020 edition, import it for side effects only
+package y2020
+
+import (
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day01"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day02"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day03"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day04"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day05"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day06"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day07"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day08"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day09"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day10"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day11"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day12"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day13"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day14"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day15"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day16"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day17"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day18"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day19"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day20"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day21"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day22"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day23"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day24"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020/day25"
+)
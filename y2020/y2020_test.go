@@ -0,0 +1,136 @@
+package y2020_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tomas-hanicinec/AdventOfCode_2020/solver"
+	"github.com/tomas-hanicinec/AdventOfCode_2020/verify"
+	_ "github.com/tomas-hanicinec/AdventOfCode_2020/y2020"
+)
+
+const inputsDir = "../inputs"
+
+// puzzles taking seconds to solve, skipped in the -short mode
+var slowPuzzles = map[string]bool{
+	"2020/15": true,
+	"2020/23": true,
+}
+
+func TestAnswers(t *testing.T) {
+	answers, err := verify.LoadAnswers(filepath.Join(inputsDir, "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, puzzle := range solver.All() {
+		if puzzle.Year != 2020 {
+			continue
+		}
+		puzzle := puzzle
+		t.Run(puzzle.String(), func(t *testing.T) {
+			if testing.Short() && slowPuzzles[puzzle.String()] {
+				t.Skip("slow puzzle skipped in short mode")
+			}
+			input, err := os.Open(filepath.Join(inputsDir, puzzle.InputFile()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer input.Close()
+
+			results := verify.Run(context.Background(), puzzle, input, answers)
+			if len(results) == 0 {
+				t.Fatalf("no known answers for [%s]", puzzle)
+			}
+			for _, result := range results {
+				if !result.Passed() {
+					t.Errorf("part %d does not match the known answer\n%s", result.Part, result.Diff())
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkPuzzles measures the parsing and both parts of every day separately, e.g. -bench 'Puzzles/2020/15/part2'
+func BenchmarkPuzzles(b *testing.B) {
+	for _, puzzle := range solver.All() {
+		if puzzle.Year != 2020 {
+			continue
+		}
+		input, err := os.ReadFile(filepath.Join(inputsDir, puzzle.InputFile()))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(puzzle.String()+"/parse", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := puzzle.New().Parse(bytes.NewReader(input)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		for _, part := range []int{1, 2} {
+			b.Run(fmt.Sprintf("%s/part%d", puzzle, part), func(b *testing.B) {
+				s := puzzle.New()
+				if err := s.Parse(bytes.NewReader(input)); err != nil {
+					b.Fatal(err)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := solver.SolvePart(context.Background(), s, part); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestVersions(t *testing.T) {
+	for _, puzzle := range solver.All() {
+		if puzzle.Year != 2020 {
+			continue
+		}
+		if _, ok := puzzle.Version(); !ok {
+			t.Errorf("%s has no version, its answers would never be cached", puzzle)
+		}
+	}
+}
+
+// TestInterruptible checks that every part of the interruptible solvers stops on a cancelled context, the server
+// relies on it to release the workers of the timed out requests
+func TestInterruptible(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, puzzle := range solver.All() {
+		if _, ok := puzzle.New().(solver.Interruptible); puzzle.Year != 2020 || !ok {
+			continue
+		}
+		input, err := os.ReadFile(filepath.Join(inputsDir, puzzle.InputFile()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, part := range []int{1, 2} {
+			s := puzzle.New()
+			if err = s.Parse(bytes.NewReader(input)); err != nil {
+				t.Fatal(err)
+			}
+			s.(solver.Interruptible).SetContext(ctx)
+			solve := s.PartOne
+			if part == 2 {
+				solve = s.PartTwo
+			}
+			var interrupted *solver.InterruptedError
+			if answer, err := solve(); !errors.As(err, &interrupted) {
+				t.Errorf("%s part %d = (%q, %v), want interrupted", puzzle, part, answer, err)
+			}
+		}
+	}
+}